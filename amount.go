@@ -0,0 +1,49 @@
+package solanaparser
+
+import (
+	"math/big"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
+
+// fillUiAmounts sets the decimals & ui amounts of the events after the whole transaction is parsed,
+// so that decimals added by later instructions, e.g. TransferChecked, are used too
+func fillUiAmounts(t *types.Transaction) {
+	t.Walk(func(in *types.Instruction) bool {
+		for _, event := range in.Event {
+			fillUiAmount(t.Meta, event)
+		}
+		for _, event := range in.Receipt {
+			fillUiAmount(t.Meta, event)
+		}
+		return true
+	})
+}
+
+func fillUiAmount(meta *types.Meta, event types.Event) {
+	switch e := event.(type) {
+	case *types.Transfer:
+		if !hasMint(e) {
+			return
+		}
+		e.Decimals, e.UiAmount, _ = uiAmount(meta, e.Mint, e.Amount)
+	case *types.MintTo:
+		e.Decimals, e.UiAmount, _ = uiAmount(meta, e.Mint, e.Amount)
+	case *types.Burn:
+		e.Decimals, e.UiAmount, _ = uiAmount(meta, e.Mint, e.Amount)
+	case *types.SwapEvent:
+		e.InputDecimals, e.InputUiAmount, _ = uiAmount(meta, e.InputMint, e.InputAmount)
+		e.OutputDecimals, e.OutputUiAmount, _ = uiAmount(meta, e.OutputMint, e.OutputAmount)
+	}
+}
+
+// uiAmount is the decimal adjusted amount of a mint, false if the decimals of the mint are unknown
+func uiAmount(meta *types.Meta, mint solana.PublicKey, amount uint64) (uint8, decimal.Decimal, bool) {
+	mintAccount, ok := meta.MintAccounts[mint]
+	if !ok {
+		return 0, decimal.Decimal{}, false
+	}
+	return mintAccount.Decimals, decimal.NewFromBigInt(new(big.Int).SetUint64(amount), -int32(mintAccount.Decimals)), true
+}
@@ -0,0 +1,72 @@
+package solanaparser
+
+import (
+	"bytes"
+	"sort"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
+
+type balanceKey struct {
+	owner solana.PublicKey
+	mint  solana.PublicKey
+}
+
+// balanceChanges aggregates the pre & post balances of token accounts and sol accounts by owner and mint
+func balanceChanges(meta *types.Meta) []*types.BalanceChange {
+	changes := make(map[balanceKey]*types.BalanceChange)
+	add := func(owner solana.PublicKey, mint solana.PublicKey, pre decimal.Decimal, post decimal.Decimal) {
+		key := balanceKey{owner: owner, mint: mint}
+		change, ok := changes[key]
+		if !ok {
+			change = &types.BalanceChange{Owner: owner, Mint: mint}
+			changes[key] = change
+		}
+		if mintAccount, ok := meta.MintAccounts[mint]; ok {
+			pre = pre.Shift(-int32(mintAccount.Decimals))
+			post = post.Shift(-int32(mintAccount.Decimals))
+		}
+		change.Pre = change.Pre.Add(pre)
+		change.Post = change.Post.Add(post)
+	}
+	// token
+	accounts := make(map[solana.PublicKey]struct{})
+	for account := range meta.TokenPreBalance {
+		accounts[account] = struct{}{}
+	}
+	for account := range meta.TokenPostBalance {
+		accounts[account] = struct{}{}
+	}
+	for account := range accounts {
+		tokenAccount, ok := meta.TokenAccounts[account]
+		if !ok {
+			continue
+		}
+		owner := account
+		if tokenAccount.Owner != nil {
+			owner = *tokenAccount.Owner
+		}
+		add(owner, tokenAccount.Mint, meta.TokenPreBalance[account], meta.TokenPostBalance[account])
+	}
+	// sol
+	for account, pre := range meta.SolPreBalance {
+		add(account, solana.PublicKey{}, pre, meta.SolPostBalance[account])
+	}
+	result := make([]*types.BalanceChange, 0, len(changes))
+	for _, change := range changes {
+		change.Change = change.Post.Sub(change.Pre)
+		if change.Change.IsZero() {
+			continue
+		}
+		result = append(result, change)
+	}
+	sort.Slice(result, func(i, j int) bool {
+		if c := bytes.Compare(result[i].Owner[:], result[j].Owner[:]); c != 0 {
+			return c < 0
+		}
+		return bytes.Compare(result[i].Mint[:], result[j].Mint[:]) < 0
+	})
+	return result
+}
@@ -0,0 +1,41 @@
+// Command coverage reports the programs & instructions the parser supports and how each one is handled,
+// with -blocks it also counts the instructions of recorded blocks.
+//
+//	go run ./cmd/coverage -blocks ./blocks
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/blockchain-develop/solana-parser/coverage"
+	"github.com/blockchain-develop/solana-parser/program"
+)
+
+func main() {
+	blocks := flag.String("blocks", "", "directory of blocks recorded as json files of rpc.GetBlockResult")
+	asJson := flag.Bool("json", false, "write the report as json")
+	flag.Parse()
+
+	report := coverage.Build(program.DefaultRegistry)
+	if *blocks != "" {
+		if err := report.CountDir(*blocks); err != nil {
+			fmt.Fprintln(os.Stderr, err)
+			os.Exit(1)
+		}
+	}
+	var err error
+	if *asJson {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		err = encoder.Encode(report)
+	} else {
+		err = report.WriteText(os.Stdout)
+	}
+	if err != nil {
+		fmt.Fprintln(os.Stderr, err)
+		os.Exit(1)
+	}
+}
@@ -0,0 +1,83 @@
+package coverage
+
+import (
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"strings"
+)
+
+// classifier classifies handlers from the ast of their source files
+type classifier struct {
+	files map[string]*ast.File
+}
+
+func newClassifier() *classifier {
+	return &classifier{
+		files: make(map[string]*ast.File),
+	}
+}
+
+func (c *classifier) classify(file string, name string) Class {
+	f, ok := c.files[file]
+	if !ok {
+		var err error
+		f, err = parser.ParseFile(token.NewFileSet(), file, nil, 0)
+		if err != nil {
+			f = nil
+		}
+		c.files[file] = f
+	}
+	if f == nil {
+		return ClassUnknown
+	}
+	for _, decl := range f.Decls {
+		fn, ok := decl.(*ast.FuncDecl)
+		if !ok || fn.Recv != nil || fn.Name.Name != name || fn.Body == nil {
+			continue
+		}
+		return classifyBody(fn.Body)
+	}
+	return ClassUnknown
+}
+
+func classifyBody(body *ast.BlockStmt) Class {
+	events, logs := false, false
+	ast.Inspect(body, func(n ast.Node) bool {
+		switch node := n.(type) {
+		case *ast.AssignStmt:
+			for _, lhs := range node.Lhs {
+				if selector, ok := lhs.(*ast.SelectorExpr); ok && selector.Sel.Name == "Event" {
+					events = true
+				}
+			}
+		case *ast.BasicLit:
+			if node.Kind == token.STRING && strings.Contains(node.Value, "ignore parse") {
+				logs = true
+			}
+		}
+		return true
+	})
+	switch {
+	case events:
+		return ClassEvents
+	case logs:
+		return ClassLogOnly
+	case isReturnNil(body):
+		return ClassIgnored
+	}
+	return ClassNoEvents
+}
+
+// isReturnNil reports whether the body is only "return nil"
+func isReturnNil(body *ast.BlockStmt) bool {
+	if len(body.List) != 1 {
+		return false
+	}
+	ret, ok := body.List[0].(*ast.ReturnStmt)
+	if !ok || len(ret.Results) != 1 {
+		return false
+	}
+	ident, ok := ret.Results[0].(*ast.Ident)
+	return ok && ident.Name == "nil"
+}
@@ -0,0 +1,233 @@
+package coverage
+
+import (
+	"encoding/binary"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"runtime"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/anchor"
+	"github.com/blockchain-develop/solana-parser/program/jupiter"
+	"github.com/blockchain-develop/solana-parser/program/lifinity"
+	"github.com/blockchain-develop/solana-parser/program/meteora_dlmm"
+	"github.com/blockchain-develop/solana-parser/program/meteora_pools"
+	obricv2 "github.com/blockchain-develop/solana-parser/program/obric_v2"
+	phoenix "github.com/blockchain-develop/solana-parser/program/phoenix"
+	"github.com/blockchain-develop/solana-parser/program/pump"
+	"github.com/blockchain-develop/solana-parser/program/raydium_amm"
+	"github.com/blockchain-develop/solana-parser/program/raydium_clmm"
+	"github.com/blockchain-develop/solana-parser/program/raydium_cp"
+	"github.com/blockchain-develop/solana-parser/program/solfi"
+	"github.com/blockchain-develop/solana-parser/program/spl_token"
+	"github.com/blockchain-develop/solana-parser/program/spl_token_2022"
+	"github.com/blockchain-develop/solana-parser/program/stable_swap"
+	"github.com/blockchain-develop/solana-parser/program/system"
+	"github.com/blockchain-develop/solana-parser/program/whirlpool"
+	"github.com/gagliardetto/solana-go"
+	jupiter_program "github.com/gagliardetto/solana-go/programs/jupiter"
+	lifinity_program "github.com/gagliardetto/solana-go/programs/lifinity_v2"
+	meteora_dlmm_program "github.com/gagliardetto/solana-go/programs/meteora_dlmm"
+	meteora_pools_program "github.com/gagliardetto/solana-go/programs/meteora_pools"
+	obric_v2_program "github.com/gagliardetto/solana-go/programs/obric_v2"
+	phoenix_program "github.com/gagliardetto/solana-go/programs/phoenix_v1"
+	pump_program "github.com/gagliardetto/solana-go/programs/pumpfun"
+	raydium_amm_program "github.com/gagliardetto/solana-go/programs/raydium_amm"
+	raydium_clmm_program "github.com/gagliardetto/solana-go/programs/raydium_clmm"
+	raydium_cp_program "github.com/gagliardetto/solana-go/programs/raydium_cp"
+	solfi_program "github.com/gagliardetto/solana-go/programs/solfi"
+	stable_swap_program "github.com/gagliardetto/solana-go/programs/stable_swap"
+	whirlpool_program "github.com/gagliardetto/solana-go/programs/whirlpool"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// Class is how a handler deals with its instruction
+type Class string
+
+const (
+	// ClassEvents handlers set in.Event
+	ClassEvents Class = "events"
+	// ClassNoEvents handlers do some work, e.g. receipts, but do not set in.Event
+	ClassNoEvents Class = "no-events"
+	// ClassLogOnly handlers only log "ignore parse ..."
+	ClassLogOnly Class = "log-only"
+	// ClassIgnored handlers only return nil
+	ClassIgnored Class = "ignored"
+	// ClassUnknown handlers have no source available, e.g. a binary built with -trimpath
+	ClassUnknown Class = "unknown"
+)
+
+// packages are the per instruction Parsers maps of the program packages
+var packages = map[solana.PublicKey]interface{}{
+	jupiter_program.ProgramID:       jupiter.Parsers,
+	lifinity_program.ProgramID:      lifinity.Parsers,
+	meteora_dlmm_program.ProgramID:  meteora_dlmm.Parsers,
+	meteora_pools_program.ProgramID: meteora_pools.Parsers,
+	obric_v2_program.ProgramID:      obricv2.Parsers,
+	phoenix_program.ProgramID:       phoenix.Parsers,
+	pump_program.ProgramID:          pump.Parsers,
+	raydium_amm_program.ProgramID:   raydium_amm.Parsers,
+	raydium_clmm_program.ProgramID:  raydium_clmm.Parsers,
+	raydium_cp_program.ProgramID:    raydium_cp.Parsers,
+	solfi_program.ProgramID:         solfi.Parsers,
+	solana.TokenProgramID:           spl_token.Parsers,
+	solana.Token2022ProgramID:       spl_token_2022.Parsers,
+	stable_swap_program.ProgramID:   stable_swap.Parsers,
+	solana.SystemProgramID:          system.Parsers,
+	whirlpool_program.ProgramID:     whirlpool.Parsers,
+}
+
+// Handler is the parser of one instruction of a program
+type Handler struct {
+	Id    uint64
+	Name  string
+	Class Class
+	Hits  uint64
+}
+
+// Program is one registered program with its instruction handlers,
+// Unknown counts the instructions of recorded blocks without handler
+type Program struct {
+	Id       solana.PublicKey
+	Name     string
+	Type     string
+	Handlers []*Handler
+	Hits     uint64
+	Unknown  uint64
+}
+
+type Report struct {
+	Programs []*Program
+	programs map[solana.PublicKey]*Program
+}
+
+// Build enumerates the programs of the registry and classifies the handlers of their packages,
+// handlers are classified from their source which must be available where the binary was built
+func Build(registry *program.Registry) *Report {
+	report := &Report{
+		programs: make(map[solana.PublicKey]*Program),
+	}
+	classifier := newClassifier()
+	for _, registration := range registry.Programs() {
+		p := &Program{
+			Id:   registration.Id,
+			Name: registration.Name,
+			Type: registration.Type,
+		}
+		if parsers, ok := packages[registration.Id]; ok {
+			m := reflect.ValueOf(parsers)
+			for _, key := range m.MapKeys() {
+				fn := runtime.FuncForPC(m.MapIndex(key).Pointer())
+				handler := &Handler{
+					Id:    key.Uint(),
+					Class: ClassUnknown,
+				}
+				if fn != nil {
+					file, _ := fn.FileLine(fn.Entry())
+					name := fn.Name()
+					handler.Name = name[strings.LastIndex(name, ".")+1:]
+					handler.Class = classifier.classify(file, handler.Name)
+				}
+				p.Handlers = append(p.Handlers, handler)
+			}
+			sort.Slice(p.Handlers, func(i, j int) bool {
+				return p.Handlers[i].Name < p.Handlers[j].Name
+			})
+		}
+		report.Programs = append(report.Programs, p)
+		report.programs[p.Id] = p
+	}
+	return report
+}
+
+// CountBlock counts the instructions of a block by program and handler
+func (r *Report) CountBlock(b *rpc.GetBlockResult) {
+	for _, item := range b.Transactions {
+		tx, err := item.GetTransaction()
+		if err != nil || item.Meta == nil {
+			continue
+		}
+		accounts := append([]solana.PublicKey{}, tx.Message.AccountKeys...)
+		accounts = append(accounts, item.Meta.LoadedAddresses.Writable...)
+		accounts = append(accounts, item.Meta.LoadedAddresses.ReadOnly...)
+		instructions := append([]solana.CompiledInstruction{}, tx.Message.Instructions...)
+		for _, inner := range item.Meta.InnerInstructions {
+			instructions = append(instructions, inner.Instructions...)
+		}
+		for _, instruction := range instructions {
+			if int(instruction.ProgramIDIndex) >= len(accounts) {
+				continue
+			}
+			r.count(accounts[instruction.ProgramIDIndex], instruction.Data)
+		}
+	}
+}
+
+func (r *Report) count(programId solana.PublicKey, data []byte) {
+	p, ok := r.programs[programId]
+	if !ok || anchor.IsSelfCPILog(data) {
+		return
+	}
+	p.Hits++
+	// anchor & u32 instructions are keyed by the first 4 bytes, u8 instructions by the first byte
+	var id uint64
+	switch length := program.DiscriminatorLength(p.Id); {
+	case length == 1 && len(data) >= 1:
+		id = uint64(data[0])
+	case length >= 4 && len(data) >= 4:
+		id = uint64(binary.LittleEndian.Uint32(data))
+	default:
+		p.Unknown++
+		return
+	}
+	for _, handler := range p.Handlers {
+		if handler.Id == id {
+			handler.Hits++
+			return
+		}
+	}
+	p.Unknown++
+}
+
+// CountDir counts the blocks recorded as json files of rpc.GetBlockResult in the directory
+func (r *Report) CountDir(dir string) error {
+	files, err := filepath.Glob(filepath.Join(dir, "*.json"))
+	if err != nil {
+		return err
+	}
+	for _, file := range files {
+		data, err := os.ReadFile(file)
+		if err != nil {
+			return err
+		}
+		var b rpc.GetBlockResult
+		if err := json.Unmarshal(data, &b); err != nil {
+			return fmt.Errorf("%s: %w", file, err)
+		}
+		r.CountBlock(&b)
+	}
+	return nil
+}
+
+// WriteText writes the report as a table, one line per handler
+func (r *Report) WriteText(w io.Writer) error {
+	tw := tabwriter.NewWriter(w, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(tw, "PROGRAM\tTYPE\tHANDLER\tID\tCLASS\tHITS")
+	for _, p := range r.Programs {
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\n", p.Name, p.Type, "*", "", "", p.Hits)
+		for _, handler := range p.Handlers {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%#x\t%s\t%d\n", p.Name, p.Type, handler.Name, handler.Id, handler.Class, handler.Hits)
+		}
+		if p.Unknown > 0 {
+			fmt.Fprintf(tw, "%s\t%s\t%s\t%s\t%s\t%d\n", p.Name, p.Type, "?", "", "", p.Unknown)
+		}
+	}
+	return tw.Flush()
+}
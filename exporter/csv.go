@@ -0,0 +1,80 @@
+package exporter
+
+import (
+	"encoding/csv"
+	"fmt"
+	"io"
+	"reflect"
+	"strconv"
+)
+
+type csvTable struct {
+	writer *csv.Writer
+	output io.Closer
+}
+
+// NewCSVWriter writes every table to "<dir>/<table>.csv" with a header line
+func NewCSVWriter(dir string) (Writer, error) {
+	return newWriter(func(table string) (tableWriter, error) {
+		file, err := createFile(dir, table, ".csv")
+		if err != nil {
+			return nil, err
+		}
+		return newCSVTable(table, file)
+	})
+}
+
+// NewCSVWriterTo writes every table to its output, the outputs are not closed by Close
+func NewCSVWriterTo(outputs map[string]io.Writer) (Writer, error) {
+	return newWriter(func(table string) (tableWriter, error) {
+		output, ok := outputs[table]
+		if !ok {
+			return nil, fmt.Errorf("output not found")
+		}
+		return newCSVTable(table, nopCloser{output})
+	})
+}
+
+func newCSVTable(table string, output io.WriteCloser) (*csvTable, error) {
+	t := &csvTable{
+		writer: csv.NewWriter(output),
+		output: output,
+	}
+	if err := t.writer.Write(Columns(newRow(table))); err != nil {
+		output.Close()
+		return nil, err
+	}
+	return t, nil
+}
+
+func (t *csvTable) write(row interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(row))
+	record := make([]string, 0, v.NumField())
+	for i := 0; i < v.NumField(); i++ {
+		record = append(record, csvValue(v.Field(i)))
+	}
+	return t.writer.Write(record)
+}
+
+func (t *csvTable) close() error {
+	t.writer.Flush()
+	if err := t.writer.Error(); err != nil {
+		t.output.Close()
+		return err
+	}
+	return t.output.Close()
+}
+
+func csvValue(v reflect.Value) string {
+	switch v.Kind() {
+	case reflect.String:
+		return v.String()
+	case reflect.Bool:
+		return strconv.FormatBool(v.Bool())
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return strconv.FormatInt(v.Int(), 10)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return strconv.FormatUint(v.Uint(), 10)
+	}
+	return fmt.Sprint(v.Interface())
+}
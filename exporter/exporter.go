@@ -0,0 +1,108 @@
+// Package exporter flattens parsed blocks into typed rows, one table per kind of row,
+// and writes the tables as csv or parquet files for analytics warehouses.
+// The columns of each table are documented on its row struct in rows.go,
+// parquet files are written with github.com/xitongsys/parquet-go, snappy compressed with required columns.
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"reflect"
+	"strings"
+
+	"github.com/blockchain-develop/solana-parser/types"
+)
+
+// Writer streams the rows of parsed blocks into the tables
+type Writer interface {
+	WriteBlock(block *types.Block) error
+	Close() error
+}
+
+// tableWriter writes the rows of one table
+type tableWriter interface {
+	write(row interface{}) error
+	close() error
+}
+
+// writer dispatches the rows of the blocks to the table writers
+type writer struct {
+	tables map[string]tableWriter
+}
+
+func (w *writer) WriteBlock(block *types.Block) error {
+	return Flatten(block, func(table string, row interface{}) error {
+		t, ok := w.tables[table]
+		if !ok {
+			return fmt.Errorf("table %s not found", table)
+		}
+		return t.write(row)
+	})
+}
+
+// Close flushes & closes all the tables, the first error is returned
+func (w *writer) Close() error {
+	var first error
+	for _, table := range Tables {
+		if err := w.tables[table].close(); err != nil && first == nil {
+			first = fmt.Errorf("close %s: %w", table, err)
+		}
+	}
+	return first
+}
+
+// newWriter opens the output of every table with open, the opened tables are closed on error
+func newWriter(open func(table string) (tableWriter, error)) (*writer, error) {
+	w := &writer{tables: make(map[string]tableWriter, len(Tables))}
+	for _, table := range Tables {
+		t, err := open(table)
+		if err != nil {
+			for _, opened := range w.tables {
+				opened.close()
+			}
+			return nil, fmt.Errorf("open %s: %w", table, err)
+		}
+		w.tables[table] = t
+	}
+	return w, nil
+}
+
+// createFile creates the file of a table in the directory
+func createFile(dir string, table string, ext string) (*os.File, error) {
+	if err := os.MkdirAll(dir, 0755); err != nil {
+		return nil, err
+	}
+	return os.Create(filepath.Join(dir, table+ext))
+}
+
+// Columns are the column names of a row struct, in order
+func Columns(row interface{}) []string {
+	t := reflect.Indirect(reflect.ValueOf(row)).Type()
+	columns := make([]string, 0, t.NumField())
+	for i := 0; i < t.NumField(); i++ {
+		columns = append(columns, columnName(t.Field(i)))
+	}
+	return columns
+}
+
+// columnName is the name of the parquet tag, the field name if there is none
+func columnName(field reflect.StructField) string {
+	for _, option := range strings.Split(field.Tag.Get("parquet"), ",") {
+		option = strings.TrimSpace(option)
+		if strings.HasPrefix(option, "name=") {
+			return strings.TrimPrefix(option, "name=")
+		}
+	}
+	return field.Name
+}
+
+// nopCloser keeps the outputs of the caller open
+type nopCloser struct {
+	io.Writer
+}
+
+func (nopCloser) Close() error {
+	return nil
+}
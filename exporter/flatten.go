@@ -0,0 +1,213 @@
+package exporter
+
+import (
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
+
+// Emit receives the rows of a block one by one, row is a pointer to the row struct of the table
+type Emit func(table string, row interface{}) error
+
+// Flatten streams the rows of all the tables of a parsed block, transaction by transaction in block order
+func Flatten(block *types.Block, emit Emit) error {
+	for _, t := range block.Transaction {
+		if err := FlattenTransaction(block, t, emit); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// FlattenTransaction streams the rows of one transaction of the block
+func FlattenTransaction(block *types.Block, t *types.Transaction, emit Emit) error {
+	slot, blockTime := int64(block.Slot), int64(block.Time)
+	signature := t.Hash.String()
+	row := &TransactionRow{
+		Slot:             slot,
+		BlockTime:        blockTime,
+		Signature:        signature,
+		Seq:              int64(t.Seq),
+		Failed:           t.Failed,
+		InstructionCount: int64(len(t.Instructions)),
+		DiagnosticCount:  int64(len(t.Diagnostics)),
+	}
+	if t.Meta != nil {
+		row.ErrorMessage = string(t.Meta.ErrorMessage)
+	}
+	if err := emit(TableTransactions, row); err != nil {
+		return err
+	}
+	var err error
+	t.Walk(func(in *types.Instruction) bool {
+		if err == nil {
+			err = flattenInstruction(slot, blockTime, t, in, emit)
+		}
+		return err == nil
+	})
+	if err != nil {
+		return err
+	}
+	if t.Meta == nil {
+		return nil
+	}
+	for _, change := range t.Meta.BalanceChanges {
+		if err := emit(TableBalanceChanges, &BalanceChangeRow{
+			Slot:      slot,
+			BlockTime: blockTime,
+			Signature: signature,
+			Owner:     change.Owner.String(),
+			Mint:      change.Mint.String(),
+			Pre:       change.Pre.String(),
+			Post:      change.Post.String(),
+			Change:    change.Change.String(),
+		}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func flattenInstruction(slot int64, blockTime int64, t *types.Transaction, in *types.Instruction, emit Emit) error {
+	signature := t.Hash.String()
+	path := in.PathString()
+	if err := emit(TableInstructions, &InstructionRow{
+		Slot:         slot,
+		BlockTime:    blockTime,
+		Signature:    signature,
+		Path:         path,
+		Depth:        int64(in.Depth),
+		Program:      in.RawInstruction.ProgID.String(),
+		EventCount:   int64(len(in.Event)),
+		ReceiptCount: int64(len(in.Receipt)),
+		ComputeUnits: int64(in.ComputeUnits),
+		Success:      in.Success,
+		Failed:       in.Failed,
+		Error:        in.Error,
+	}); err != nil {
+		return err
+	}
+	for i, event := range in.Event {
+		table, row := eventRow(event)
+		if row == nil {
+			continue
+		}
+		row.setKey(slot, blockTime, signature, path, in.EventID(t.Hash, i))
+		if err := emit(table, row); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// eventRow is an event row without its key, nil for events which are not exported
+func eventRow(event types.Event) (string, eventKeySetter) {
+	switch e := event.(type) {
+	case *types.Transfer:
+		row := &TransferRow{Kind: e.Kind(), Program: e.ProgramId.String(), Mint: e.Mint.String(), From: e.From.String(), To: e.To.String(), Amount: e.Amount}
+		row.Decimals, row.UiAmount = uiAmount(e.Decimals, e.UiAmount, e.Amount)
+		return TableTransfers, row
+	case *types.MintTo:
+		row := &TransferRow{Kind: e.Kind(), Program: e.ProgramId.String(), Mint: e.Mint.String(), To: e.Account.String(), Amount: e.Amount}
+		row.Decimals, row.UiAmount = uiAmount(e.Decimals, e.UiAmount, e.Amount)
+		return TableTransfers, row
+	case *types.Burn:
+		row := &TransferRow{Kind: e.Kind(), Program: e.ProgramId.String(), Mint: e.Mint.String(), From: e.Account.String(), Amount: e.Amount}
+		row.Decimals, row.UiAmount = uiAmount(e.Decimals, e.UiAmount, e.Amount)
+		return TableTransfers, row
+	case *types.Swap:
+		return TableSwaps, swapRow(e.Kind(), e.Dex, e.Pool, e.User, &e.SwapAmounts)
+	case *types.MemeBuy:
+		return TableSwaps, swapRow(e.Kind(), e.Dex, e.BondingCurve, e.User, &e.SwapAmounts)
+	case *types.MemeSell:
+		return TableSwaps, swapRow(e.Kind(), e.Dex, e.BondingCurve, e.User, &e.SwapAmounts)
+	case *types.CreatePool:
+		return TableLiquidity, &LiquidityRow{
+			Kind:   e.Kind(),
+			Dex:    e.Dex.String(),
+			Pool:   e.Pool.String(),
+			User:   e.User.String(),
+			MintA:  e.TokenA.String(),
+			MintB:  e.TokenB.String(),
+			MintLp: keyString(e.TokenLP),
+		}
+	case *types.AddLiquidity:
+		row := &LiquidityRow{Kind: e.Kind(), Dex: e.Dex.String(), Pool: e.Pool.String(), User: e.User.String()}
+		row.MintA, row.AmountA = transferAmount(e.TokenATransfer)
+		row.MintB, row.AmountB = transferAmount(e.TokenBTransfer)
+		if e.TokenLpMint != nil {
+			row.MintLp, row.AmountLp = e.TokenLpMint.Mint.String(), e.TokenLpMint.Amount
+		}
+		return TableLiquidity, row
+	case *types.RemoveLiquidity:
+		row := &LiquidityRow{Kind: e.Kind(), Dex: e.Dex.String(), Pool: e.Pool.String(), User: e.User.String()}
+		row.MintA, row.AmountA = transferAmount(e.TokenATransfer)
+		row.MintB, row.AmountB = transferAmount(e.TokenBTransfer)
+		if e.TokenLpBurn != nil {
+			row.MintLp, row.AmountLp = e.TokenLpBurn.Mint.String(), e.TokenLpBurn.Amount
+		}
+		return TableLiquidity, row
+	}
+	return "", nil
+}
+
+func swapRow(kind string, dex solana.PublicKey, pool solana.PublicKey, user solana.PublicKey, amounts *types.SwapAmounts) *SwapRow {
+	row := &SwapRow{
+		Kind:   kind,
+		Dex:    dex.String(),
+		Pool:   pool.String(),
+		User:   user.String(),
+		Source: amounts.Source,
+	}
+	if amounts.Source == "" {
+		return row
+	}
+	row.MintIn = amounts.MintIn.String()
+	row.MintOut = amounts.MintOut.String()
+	row.AmountIn = amounts.AmountIn
+	row.AmountOut = amounts.AmountOut
+	if !amounts.Price.IsZero() {
+		row.Price = amounts.Price.String()
+	}
+	return row
+}
+
+func transferAmount(transfer *types.Transfer) (string, uint64) {
+	if transfer == nil {
+		return "", 0
+	}
+	return keyString(transfer.Mint), transfer.Amount
+}
+
+// uiAmount leaves the decimals & the ui amount empty when the decimals of the mint are unknown
+func uiAmount(decimals uint8, ui decimal.Decimal, amount uint64) (int32, string) {
+	if ui.IsZero() && amount != 0 {
+		return 0, ""
+	}
+	return int32(decimals), ui.String()
+}
+
+// keyString is empty for the zero key of unknown accounts
+func keyString(key solana.PublicKey) string {
+	if key.IsZero() {
+		return ""
+	}
+	return key.String()
+}
+
+// eventKeySetter sets the columns shared by the event rows
+type eventKeySetter interface {
+	setKey(slot int64, blockTime int64, signature string, path string, eventId string)
+}
+
+func (r *TransferRow) setKey(slot int64, blockTime int64, signature string, path string, eventId string) {
+	r.Slot, r.BlockTime, r.Signature, r.Path, r.EventId = slot, blockTime, signature, path, eventId
+}
+
+func (r *SwapRow) setKey(slot int64, blockTime int64, signature string, path string, eventId string) {
+	r.Slot, r.BlockTime, r.Signature, r.Path, r.EventId = slot, blockTime, signature, path, eventId
+}
+
+func (r *LiquidityRow) setKey(slot int64, blockTime int64, signature string, path string, eventId string) {
+	r.Slot, r.BlockTime, r.Signature, r.Path, r.EventId = slot, blockTime, signature, path, eventId
+}
@@ -0,0 +1,88 @@
+package exporter
+
+import (
+	"fmt"
+	"io"
+	"reflect"
+
+	"github.com/xitongsys/parquet-go/parquet"
+	parquetwriter "github.com/xitongsys/parquet-go/writer"
+)
+
+// ParquetRowGroupSize is the size in bytes of the rows buffered in memory before a row group is written
+var ParquetRowGroupSize int64 = 128 * 1024 * 1024
+
+// parquetTable writes the rows of one table as a snappy compressed parquet file, the schema is read from the parquet tags.
+// parquet-go takes the values of UINT_64 columns as int64, so the rows are copied to rowType,
+// the row struct with the uint64 fields changed to int64
+type parquetTable struct {
+	writer  *parquetwriter.ParquetWriter
+	output  io.Closer
+	rowType reflect.Type
+}
+
+// NewParquetWriter writes every table to "<dir>/<table>.parquet"
+func NewParquetWriter(dir string) (Writer, error) {
+	return newWriter(func(table string) (tableWriter, error) {
+		file, err := createFile(dir, table, ".parquet")
+		if err != nil {
+			return nil, err
+		}
+		return newParquetTable(table, file)
+	})
+}
+
+// NewParquetWriterTo writes every table to its output, the outputs are not closed by Close
+func NewParquetWriterTo(outputs map[string]io.Writer) (Writer, error) {
+	return newWriter(func(table string) (tableWriter, error) {
+		output, ok := outputs[table]
+		if !ok {
+			return nil, fmt.Errorf("output not found")
+		}
+		return newParquetTable(table, nopCloser{output})
+	})
+}
+
+func newParquetTable(table string, output io.WriteCloser) (*parquetTable, error) {
+	rowType := reflect.TypeOf(newRow(table)).Elem()
+	fields := make([]reflect.StructField, 0, rowType.NumField())
+	for i := 0; i < rowType.NumField(); i++ {
+		field := rowType.Field(i)
+		if field.Type.Kind() == reflect.Uint64 {
+			field.Type = reflect.TypeOf(int64(0))
+		}
+		fields = append(fields, field)
+	}
+	t := &parquetTable{output: output, rowType: reflect.StructOf(fields)}
+	w, err := parquetwriter.NewParquetWriterFromWriter(output, reflect.New(t.rowType).Interface(), 1)
+	if err != nil {
+		output.Close()
+		return nil, err
+	}
+	w.RowGroupSize = ParquetRowGroupSize
+	w.CompressionType = parquet.CompressionCodec_SNAPPY
+	t.writer = w
+	return t, nil
+}
+
+func (t *parquetTable) write(row interface{}) error {
+	v := reflect.Indirect(reflect.ValueOf(row))
+	converted := reflect.New(t.rowType).Elem()
+	for i := 0; i < v.NumField(); i++ {
+		if field := v.Field(i); field.Kind() == reflect.Uint64 {
+			converted.Field(i).SetInt(int64(field.Uint()))
+		} else {
+			converted.Field(i).Set(field)
+		}
+	}
+	return t.writer.Write(converted.Addr().Interface())
+}
+
+// close writes the last row group & the footer
+func (t *parquetTable) close() error {
+	err := t.writer.WriteStop()
+	if closeErr := t.output.Close(); err == nil {
+		err = closeErr
+	}
+	return err
+}
@@ -0,0 +1,137 @@
+package exporter
+
+// The rows of the exported tables, one struct per table.
+// The parquet tag names the column in both the csv & the parquet files, in the order of the fields.
+// Keys are base58, amounts are raw integers of the mint unless the column is a ui amount,
+// decimal columns are strings so that no precision is lost.
+
+const (
+	TableTransactions   = "transactions"
+	TableInstructions   = "instructions"
+	TableTransfers      = "transfers"
+	TableSwaps          = "swaps"
+	TableLiquidity      = "liquidity"
+	TableBalanceChanges = "balance_changes"
+)
+
+// Tables are the names of all the exported tables
+var Tables = []string{TableTransactions, TableInstructions, TableTransfers, TableSwaps, TableLiquidity, TableBalanceChanges}
+
+// TransactionRow is one parsed transaction,
+// InstructionCount counts the top level instructions & ErrorMessage is the json error of a failed transaction
+type TransactionRow struct {
+	Slot             int64  `parquet:"name=slot, type=INT64"`
+	BlockTime        int64  `parquet:"name=block_time, type=INT64"`
+	Signature        string `parquet:"name=signature, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Seq              int64  `parquet:"name=seq, type=INT64"`
+	Failed           bool   `parquet:"name=failed, type=BOOLEAN"`
+	InstructionCount int64  `parquet:"name=instruction_count, type=INT64"`
+	DiagnosticCount  int64  `parquet:"name=diagnostic_count, type=INT64"`
+	ErrorMessage     string `parquet:"name=error_message, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// InstructionRow is one instruction of the instruction tree, Path is the dotted path, e.g. "3.2.1"
+type InstructionRow struct {
+	Slot         int64  `parquet:"name=slot, type=INT64"`
+	BlockTime    int64  `parquet:"name=block_time, type=INT64"`
+	Signature    string `parquet:"name=signature, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Path         string `parquet:"name=path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Depth        int64  `parquet:"name=depth, type=INT64"`
+	Program      string `parquet:"name=program, type=BYTE_ARRAY, convertedtype=UTF8"`
+	EventCount   int64  `parquet:"name=event_count, type=INT64"`
+	ReceiptCount int64  `parquet:"name=receipt_count, type=INT64"`
+	ComputeUnits int64  `parquet:"name=compute_units, type=INT64"`
+	Success      bool   `parquet:"name=success, type=BOOLEAN"`
+	Failed       bool   `parquet:"name=failed, type=BOOLEAN"`
+	Error        string `parquet:"name=error, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// TransferRow is one transfer, mint to or burn event, Kind is the event kind,
+// From is empty for a mint to & To is empty for a burn, Decimals & UiAmount are empty if the decimals are unknown
+type TransferRow struct {
+	Slot      int64  `parquet:"name=slot, type=INT64"`
+	BlockTime int64  `parquet:"name=block_time, type=INT64"`
+	Signature string `parquet:"name=signature, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Path      string `parquet:"name=path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	EventId   string `parquet:"name=event_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Kind      string `parquet:"name=kind, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Program   string `parquet:"name=program, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Mint      string `parquet:"name=mint, type=BYTE_ARRAY, convertedtype=UTF8"`
+	From      string `parquet:"name=from, type=BYTE_ARRAY, convertedtype=UTF8"`
+	To        string `parquet:"name=to, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Amount    uint64 `parquet:"name=amount, type=INT64, convertedtype=UINT_64"`
+	Decimals  int32  `parquet:"name=decimals, type=INT32"`
+	UiAmount  string `parquet:"name=ui_amount, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// SwapRow is one swap, meme buy or meme sell event with its normalized amounts,
+// Price is AmountOut/AmountIn decimal adjusted & Source tells whether the amounts come from the transfers or the events
+type SwapRow struct {
+	Slot      int64  `parquet:"name=slot, type=INT64"`
+	BlockTime int64  `parquet:"name=block_time, type=INT64"`
+	Signature string `parquet:"name=signature, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Path      string `parquet:"name=path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	EventId   string `parquet:"name=event_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Kind      string `parquet:"name=kind, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Dex       string `parquet:"name=dex, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Pool      string `parquet:"name=pool, type=BYTE_ARRAY, convertedtype=UTF8"`
+	User      string `parquet:"name=user, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MintIn    string `parquet:"name=mint_in, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MintOut   string `parquet:"name=mint_out, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AmountIn  uint64 `parquet:"name=amount_in, type=INT64, convertedtype=UINT_64"`
+	AmountOut uint64 `parquet:"name=amount_out, type=INT64, convertedtype=UINT_64"`
+	Price     string `parquet:"name=price, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Source    string `parquet:"name=source, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// LiquidityRow is one create pool, add liquidity or remove liquidity event,
+// the amounts are the transfers of the pool tokens & of the lp token, empty mints are unknown transfers
+type LiquidityRow struct {
+	Slot      int64  `parquet:"name=slot, type=INT64"`
+	BlockTime int64  `parquet:"name=block_time, type=INT64"`
+	Signature string `parquet:"name=signature, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Path      string `parquet:"name=path, type=BYTE_ARRAY, convertedtype=UTF8"`
+	EventId   string `parquet:"name=event_id, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Kind      string `parquet:"name=kind, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Dex       string `parquet:"name=dex, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Pool      string `parquet:"name=pool, type=BYTE_ARRAY, convertedtype=UTF8"`
+	User      string `parquet:"name=user, type=BYTE_ARRAY, convertedtype=UTF8"`
+	MintA     string `parquet:"name=mint_a, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AmountA   uint64 `parquet:"name=amount_a, type=INT64, convertedtype=UINT_64"`
+	MintB     string `parquet:"name=mint_b, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AmountB   uint64 `parquet:"name=amount_b, type=INT64, convertedtype=UINT_64"`
+	MintLp    string `parquet:"name=mint_lp, type=BYTE_ARRAY, convertedtype=UTF8"`
+	AmountLp  uint64 `parquet:"name=amount_lp, type=INT64, convertedtype=UINT_64"`
+}
+
+// BalanceChangeRow is the net change of one owner for one mint across a transaction,
+// the balances are decimal adjusted & native sol uses the zero mint "11111111111111111111111111111111"
+type BalanceChangeRow struct {
+	Slot      int64  `parquet:"name=slot, type=INT64"`
+	BlockTime int64  `parquet:"name=block_time, type=INT64"`
+	Signature string `parquet:"name=signature, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Owner     string `parquet:"name=owner, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Mint      string `parquet:"name=mint, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Pre       string `parquet:"name=pre, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Post      string `parquet:"name=post, type=BYTE_ARRAY, convertedtype=UTF8"`
+	Change    string `parquet:"name=change, type=BYTE_ARRAY, convertedtype=UTF8"`
+}
+
+// newRow creates an empty row of the table, the parquet writers use it as the schema
+func newRow(table string) interface{} {
+	switch table {
+	case TableTransactions:
+		return new(TransactionRow)
+	case TableInstructions:
+		return new(InstructionRow)
+	case TableTransfers:
+		return new(TransferRow)
+	case TableSwaps:
+		return new(SwapRow)
+	case TableLiquidity:
+		return new(LiquidityRow)
+	case TableBalanceChanges:
+		return new(BalanceChangeRow)
+	}
+	return nil
+}
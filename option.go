@@ -0,0 +1,30 @@
+package solanaparser
+
+type options struct {
+	reconcile bool
+	failed    bool
+}
+
+type Option func(o *options)
+
+// WithReconcile checks the decoded transfers, mints and burns against the token balance changes
+func WithReconcile() Option {
+	return func(o *options) {
+		o.reconcile = true
+	}
+}
+
+// WithFailedTransactions decodes the instructions of failed transactions instead of discarding them
+func WithFailedTransactions() Option {
+	return func(o *options) {
+		o.failed = true
+	}
+}
+
+func newOptions(opts []Option) *options {
+	o := &options{}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
@@ -1,10 +1,17 @@
 package solanaparser
 
 import (
+	"encoding/hex"
 	"encoding/json"
+	"errors"
+	"fmt"
+	"runtime"
+	"runtime/debug"
+	"sync"
 
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/anchor"
 	_ "github.com/blockchain-develop/solana-parser/program/jupiter"
 	_ "github.com/blockchain-develop/solana-parser/program/lifinity"
 	_ "github.com/blockchain-develop/solana-parser/program/meteora_dlmm"
@@ -27,7 +34,44 @@ import (
 	"github.com/shopspring/decimal"
 )
 
-func ParseBlock(slot uint64, b *rpc.GetBlockResult) *types.Block {
+// Parser parses blocks & transactions with the programs of its registry
+type Parser struct {
+	registry *program.Registry
+	options  *options
+}
+
+// NewParser creates a parser on the registry, the program.DefaultRegistry is used if the registry is nil
+func NewParser(registry *program.Registry, opts ...Option) *Parser {
+	if registry == nil {
+		registry = program.DefaultRegistry
+	}
+	return &Parser{
+		registry: registry,
+		options:  newOptions(opts),
+	}
+}
+
+// Registry returns the registry of the parser
+func (p *Parser) Registry() *program.Registry {
+	return p.registry
+}
+
+// ParseBlock parses a block with the DefaultRegistry
+func ParseBlock(slot uint64, b *rpc.GetBlockResult, opts ...Option) *types.Block {
+	return NewParser(program.DefaultRegistry, opts...).ParseBlock(slot, b)
+}
+
+// ParseBlockConcurrent parses a block with the DefaultRegistry, see Parser.ParseBlockConcurrent
+func ParseBlockConcurrent(slot uint64, b *rpc.GetBlockResult, workers int, opts ...Option) *types.Block {
+	return NewParser(program.DefaultRegistry, opts...).ParseBlockConcurrent(slot, b, workers)
+}
+
+// ParseTransaction parses a transaction with the DefaultRegistry
+func ParseTransaction(seq int, tx *solana.Transaction, meta *rpc.TransactionMeta, opts ...Option) *types.Transaction {
+	return NewParser(program.DefaultRegistry, opts...).ParseTransaction(seq, tx, meta)
+}
+
+func (p *Parser) ParseBlock(slot uint64, b *rpc.GetBlockResult) *types.Block {
 	log.Logger.Info("parse block", "slot", slot)
 	block := &types.Block{}
 	block.Slot = slot
@@ -35,29 +79,118 @@ func ParseBlock(slot uint64, b *rpc.GetBlockResult) *types.Block {
 		log.Logger.Info("empty block", "slot", slot)
 		return block
 	}
-	block.Time = uint64(*b.BlockTime)
+	if b.BlockTime != nil {
+		block.Time = uint64(*b.BlockTime)
+	}
 	block.Hash = b.Blockhash
 	myTxs := make([]*types.Transaction, 0)
 	for i, _ := range b.Transactions {
-		tx := b.Transactions[i].MustGetTransaction()
-		meta := b.Transactions[i].Meta
-		myTx := ParseTransaction(i+1, tx, meta)
+		myTx, diagnostic := p.parseBlockTransaction(block, b, i)
+		if diagnostic != nil {
+			block.Diagnostics = append(block.Diagnostics, diagnostic)
+		}
 		if myTx == nil {
 			continue
 		}
-		if len(myTx.Instructions) == 0 {
-			// no instruction
+		myTxs = append(myTxs, myTx)
+	}
+	block.Transaction = myTxs
+	return block
+}
+
+// ParseBlockConcurrent parses the transactions of a block with a pool of workers,
+// the transactions keep the same order as ParseBlock
+func (p *Parser) ParseBlockConcurrent(slot uint64, b *rpc.GetBlockResult, workers int) *types.Block {
+	log.Logger.Info("parse block", "slot", slot, "workers", workers)
+	block := &types.Block{}
+	block.Slot = slot
+	if b == nil {
+		log.Logger.Info("empty block", "slot", slot)
+		return block
+	}
+	if b.BlockTime != nil {
+		block.Time = uint64(*b.BlockTime)
+	}
+	block.Hash = b.Blockhash
+	if workers <= 0 {
+		workers = runtime.GOMAXPROCS(0)
+	}
+	results := make([]*types.Transaction, len(b.Transactions))
+	diagnostics := make([]*types.Diagnostic, len(b.Transactions))
+	jobs := make(chan int)
+	wg := &sync.WaitGroup{}
+	for w := 0; w < workers; w++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			for i := range jobs {
+				results[i], diagnostics[i] = p.parseBlockTransaction(block, b, i)
+			}
+		}()
+	}
+	for i := range b.Transactions {
+		jobs <- i
+	}
+	close(jobs)
+	wg.Wait()
+	for _, diagnostic := range diagnostics {
+		if diagnostic != nil {
+			block.Diagnostics = append(block.Diagnostics, diagnostic)
+		}
+	}
+	myTxs := make([]*types.Transaction, 0)
+	for _, myTx := range results {
+		if myTx == nil {
 			continue
 		}
-		myTx.Slot = block.Slot
-		myTx.Time = block.Time
 		myTxs = append(myTxs, myTx)
 	}
 	block.Transaction = myTxs
 	return block
 }
 
-func ParseTransaction(seq int, tx *solana.Transaction, meta *rpc.TransactionMeta) *types.Transaction {
+// parseBlockTransaction parses one transaction of a block,
+// a panic is recovered and returned as a diagnostic so that the rest of the block is still parsed
+func (p *Parser) parseBlockTransaction(block *types.Block, b *rpc.GetBlockResult, i int) (myTx *types.Transaction, diagnostic *types.Diagnostic) {
+	var signature solana.Signature
+	defer func() {
+		if r := recover(); r != nil {
+			log.Logger.Error("parse transaction panic", "slot", block.Slot, "seq", i+1, "tx", signature.String(), "panic", r, "stack", string(debug.Stack()))
+			myTx = nil
+			diagnostic = &types.Diagnostic{
+				Signature: signature,
+				Kind:      types.DiagnosticPanic,
+				Message:   fmt.Sprint(r),
+			}
+		}
+	}()
+	tx, err := b.Transactions[i].GetTransaction()
+	if err != nil {
+		log.Logger.Error("decode transaction error", "slot", block.Slot, "seq", i+1, "err", err)
+		return nil, &types.Diagnostic{
+			Kind:    types.DiagnosticDecodeFailure,
+			Message: err.Error(),
+		}
+	}
+	if len(tx.Signatures) > 0 {
+		signature = tx.Signatures[0]
+	}
+	meta := b.Transactions[i].Meta
+	myTx = p.ParseTransaction(i+1, tx, meta)
+	if myTx == nil {
+		return nil, nil
+	}
+	if len(myTx.Instructions) == 0 {
+		// no instruction
+		return nil, nil
+	}
+	myTx.Slot = block.Slot
+	myTx.Time = block.Time
+	return myTx, nil
+}
+
+func (p *Parser) ParseTransaction(seq int, tx *solana.Transaction, meta *rpc.TransactionMeta) *types.Transaction {
+	o := p.options
 	//log.Logger.Info("parse transaction", "seq", seq, "tx", tx.Transaction.Signatures[0].String())
 	if meta == nil || tx == nil {
 		log.Logger.Error("parse transaction: meta or transaction is missing")
@@ -72,15 +205,19 @@ func ParseTransaction(seq int, tx *solana.Transaction, meta *rpc.TransactionMeta
 			TokenPostBalance: make(map[solana.PublicKey]decimal.Decimal),
 			SolPreBalance:    make(map[solana.PublicKey]decimal.Decimal),
 			SolPostBalance:   make(map[solana.PublicKey]decimal.Decimal),
+			SolBalanceDelta:  make(map[solana.PublicKey]decimal.Decimal),
 		},
 		Seq: seq,
 	}
 	t.Hash = tx.Signatures[0]
 	if meta.Err != nil {
-		// if failed, ignore this transaction
 		errJson, _ := json.Marshal(meta.Err)
 		t.Meta.ErrorMessage = errJson
-		return t
+		t.Failed = true
+		// if failed, ignore this transaction unless failed transactions are parsed
+		if !o.failed {
+			return t
+		}
 	}
 	message := tx.Message
 	if len(message.Instructions) == 0 {
@@ -147,7 +284,20 @@ func ParseTransaction(seq int, tx *solana.Transaction, meta *rpc.TransactionMeta
 		Mint:     solana.PublicKey{},
 		Decimals: 9,
 	}
-	// todo, get the sol balance
+	// sol balance, pre & post balances follow the order of the accounts
+	for index, account := range t.Meta.Accounts {
+		pre, post := decimal.Zero, decimal.Zero
+		if index < len(meta.PreBalances) {
+			pre = decimal.NewFromInt(int64(meta.PreBalances[index]))
+		}
+		if index < len(meta.PostBalances) {
+			post = decimal.NewFromInt(int64(meta.PostBalances[index]))
+		}
+		t.Meta.SolPreBalance[account.PublicKey] = pre
+		t.Meta.SolPostBalance[account.PublicKey] = post
+		t.Meta.SolBalanceDelta[account.PublicKey] = post.Sub(pre)
+	}
+	t.Meta.BalanceChanges = balanceChanges(t.Meta)
 
 	// ignore vote
 	if t.Meta.Accounts[message.Instructions[0].ProgramIDIndex].PublicKey == solana.VoteProgramID {
@@ -155,30 +305,201 @@ func ParseTransaction(seq int, tx *solana.Transaction, meta *rpc.TransactionMeta
 	}
 	log.Logger.Trace("parse transaction", "seq", seq, "tx", tx.Signatures[0].String())
 	//
+	// the index of the inner instructions is the index of the top level instruction in the message, i.e. Seq - 1
+	instructions := make(map[int]*types.Instruction, len(message.Instructions))
 	for index, instruction := range message.Instructions {
 		in := program.FilterInstruction(&instruction, t.Meta)
 		if in != nil {
 			in.Seq = index + 1
+			in.Path = []int{in.Seq}
+			in.Depth = 1
 			t.Instructions = append(t.Instructions, in)
+			instructions[in.Seq] = in
 		}
 	}
-	for _, innerInstruction := range meta.InnerInstructions {
-		parent := t.Instructions[innerInstruction.Index]
+	frames := parseLogs(meta.LogMessages)
+	innerInstructions := fillStackHeights(message.Instructions, meta.InnerInstructions, frames, t.Meta)
+	for _, innerInstruction := range innerInstructions {
+		parent, ok := instructions[int(innerInstruction.Index)+1]
+		if !ok {
+			log.Logger.Error("inner instruction index not found", "index", innerInstruction.Index, "tx", t.Hash.String())
+			continue
+		}
 		build(parent, innerInstruction.Instructions, t.Meta)
 	}
+	attachLogs(t.Instructions, frames)
+	// the parsers see which instruction of a failed transaction failed
+	if t.Failed {
+		markFailedInstruction(t)
+	}
 	for _, instruction := range t.Instructions {
-		parse(instruction, t.Meta)
+		p.parse(t, instruction)
+	}
+	fillUiAmounts(t)
+	fillSwapAmounts(t)
+	summarize(t, p.registry)
+	// balances of a failed transaction only change by the fee
+	if o.reconcile && !t.Failed {
+		reconcile(t)
 	}
 	return t
 }
 
-func parse(in *types.Instruction, meta *types.Meta) {
+// markFailedInstruction marks the top level instruction pointed by the InstructionError of a failed transaction
+func markFailedInstruction(t *types.Transaction) {
+	var txErr struct {
+		InstructionError []json.RawMessage
+	}
+	if err := json.Unmarshal(t.Meta.ErrorMessage, &txErr); err != nil || len(txErr.InstructionError) != 2 {
+		return
+	}
+	var index int
+	if err := json.Unmarshal(txErr.InstructionError[0], &index); err != nil {
+		return
+	}
+	for _, in := range t.Instructions {
+		if in.Seq == index+1 {
+			in.Failed = true
+			in.Error = string(txErr.InstructionError[1])
+		}
+	}
+}
+
+// parse parses the children and the log events before the instruction so that the parser of the instruction can use them,
+// anchor self CPI logs are decoded as events instead of being passed to the program parser
+func (p *Parser) parse(t *types.Transaction, in *types.Instruction) {
+	if anchor.IsSelfCPILog(in.RawInstruction.DataBytes) {
+		p.parseEvent(t, in)
+		return
+	}
 	for _, child := range in.Children {
-		parse(child, meta)
+		p.parse(t, child)
+	}
+	p.parseLogEvents(t, in)
+	registration, ok := p.registry.Lookup(in.RawInstruction.ProgID)
+	if !ok {
+		in.Event = append(in.Event, newUnknownInstruction(in, ""))
+		return
+	}
+	err := p.parseInstruction(in, t.Meta)
+	if err == nil {
+		return
+	}
+	if errors.Is(err, program.ErrParserNotFound) {
+		in.Event = append(in.Event, newUnknownInstruction(in, registration.Name))
+	}
+	var pe *panicError
+	if errors.As(err, &pe) {
+		log.Logger.Error("program parse panic", "err", err, "program", in.RawInstruction.ProgID.String(), "tx", t.Hash.String(), "stack", string(pe.stack))
+	} else {
+		log.Logger.Error("program parse error", "err", err, "program", in.RawInstruction.ProgID.String(), "tx", t.Hash.String())
+	}
+	for _, err := range splitErrors(err) {
+		t.Diagnostics = append(t.Diagnostics, p.newDiagnostic(t, in, err))
+	}
+}
+
+// splitErrors lists the errors joined by errors.Join, so that every one of them is its own diagnostic
+func splitErrors(err error) []error {
+	if joined, ok := err.(interface{ Unwrap() []error }); ok {
+		return joined.Unwrap()
+	}
+	return []error{err}
+}
+
+// parseEvent decodes an anchor self CPI log into the Receipt of the instruction which emitted it,
+// events which are not registered are skipped
+func (p *Parser) parseEvent(t *types.Transaction, in *types.Instruction) {
+	owner := in
+	if in.Parent != nil && in.Parent.RawInstruction.ProgID == in.RawInstruction.ProgID {
+		owner = in.Parent
+	}
+	var event types.Event
+	err := recoverPanic(func() (err error) {
+		// the event follows the self cpi log discriminator
+		event, err = p.registry.DecodeEvent(in.RawInstruction.ProgID, in.RawInstruction.DataBytes[8:])
+		return err
+	})
+	if errors.Is(err, program.ErrEventNotFound) {
+		return
 	}
-	err := program.Parse(in, meta)
 	if err != nil {
-		log.Logger.Error("program parse error", "err", err, "program", in.RawInstruction.ProgID.String())
+		log.Logger.Error("anchor event decode error", "err", err, "program", in.RawInstruction.ProgID.String(), "tx", t.Hash.String())
+		t.Diagnostics = append(t.Diagnostics, p.newDiagnostic(t, in, err))
+		return
+	}
+	owner.Receipt = append(owner.Receipt, event)
+}
+
+// panicError is a panic recovered from a program parser
+type panicError struct {
+	value interface{}
+	stack []byte
+}
+
+func (e *panicError) Error() string {
+	return fmt.Sprintf("panic: %v", e.value)
+}
+
+// parseInstruction runs the program parser of the instruction
+func (p *Parser) parseInstruction(in *types.Instruction, meta *types.Meta) error {
+	return recoverPanic(func() error {
+		return p.registry.Parse(in, meta)
+	})
+}
+
+// recoverPanic runs f, a panic of f is returned as a panicError
+func recoverPanic(f func() error) (err error) {
+	defer func() {
+		if r := recover(); r != nil {
+			err = &panicError{value: r, stack: debug.Stack()}
+		}
+	}()
+	return f()
+}
+
+// newUnknownInstruction is the event of an instruction without parser
+func newUnknownInstruction(in *types.Instruction, name string) *types.UnknownInstruction {
+	data := []byte(in.RawInstruction.DataBytes)
+	unknown := &types.UnknownInstruction{
+		ProgramId:      in.RawInstruction.ProgID,
+		ProgramName:    name,
+		Discriminator1: hex.EncodeToString(data[:min(len(data), 1)]),
+		Discriminator4: hex.EncodeToString(data[:min(len(data), 4)]),
+		Discriminator8: hex.EncodeToString(data[:min(len(data), 8)]),
+		DataLength:     len(data),
+		Accounts:       make([]solana.PublicKey, 0, len(in.RawInstruction.AccountValues)),
+	}
+	for _, account := range in.RawInstruction.AccountValues {
+		unknown.Accounts = append(unknown.Accounts, account.PublicKey)
+	}
+	return unknown
+}
+
+// newDiagnostic records the error of an instruction with the discriminator of the instruction in hex
+func (p *Parser) newDiagnostic(t *types.Transaction, in *types.Instruction, err error) *types.Diagnostic {
+	kind := types.DiagnosticDecodeFailure
+	var pe *panicError
+	switch {
+	case errors.As(err, &pe):
+		kind = types.DiagnosticPanic
+	case errors.Is(err, program.ErrParserNotFound):
+		kind = types.DiagnosticParserNotFound
+	case errors.Is(err, program.ErrTokenAccountNotFound):
+		kind = types.DiagnosticTokenAccountMissing
+	case errors.Is(err, program.ErrAmbiguousTransfer):
+		kind = types.DiagnosticAmbiguousTransfer
+	}
+	length := program.DiscriminatorLength(p.registry.Canonical(in.RawInstruction.ProgID))
+	data := []byte(in.RawInstruction.DataBytes)
+	data = data[:min(len(data), length)]
+	return &types.Diagnostic{
+		Signature:     t.Hash,
+		Path:          in.Path,
+		Program:       in.RawInstruction.ProgID,
+		Discriminator: hex.EncodeToString(data),
+		Kind:          kind,
+		Message:       err.Error(),
 	}
 }
 
@@ -205,6 +526,9 @@ func build(parent *types.Instruction, subIns []solana.CompiledInstruction, meta
 		index2 := indexes[i+1]
 		current := program.FilterInstruction(&subIns[index1], meta)
 		current.Seq = i + 1
+		current.Path = append(parent.Path[:len(parent.Path):len(parent.Path)], current.Seq)
+		current.Depth = parent.Depth + 1
+		current.Parent = parent
 		parent.Children = append(parent.Children, current)
 		build(current, subIns[index1+1:index2], meta)
 	}
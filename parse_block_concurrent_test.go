@@ -0,0 +1,50 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func newTestBlock(t *testing.T, size int) *rpc.GetBlockResult {
+	blockTime := solana.UnixTimeSeconds(1700000000)
+	b := &rpc.GetBlockResult{
+		BlockTime: &blockTime,
+	}
+	for i := 0; i < size; i++ {
+		tt := newTestTokenTransfer(t, uint64(100000+i%2))
+		tt.transaction.Signatures = []solana.Signature{{byte(i), byte(i >> 8), 1}}
+		data, err := tt.transaction.MarshalBinary()
+		if err != nil {
+			t.Fatal(err)
+		}
+		b.Transactions = append(b.Transactions, rpc.TransactionWithMeta{
+			Transaction: rpc.DataBytesOrJSONFromBytes(data),
+			Meta:        tt.meta,
+		})
+	}
+	return b
+}
+
+func TestBlock_ParseConcurrent(t *testing.T) {
+	b := newTestBlock(t, 64)
+	expected := ParseBlock(1, b, WithReconcile())
+	for _, workers := range []int{0, 1, 8} {
+		block := ParseBlockConcurrent(1, b, workers, WithReconcile())
+		if len(block.Transaction) != len(expected.Transaction) {
+			t.Fatalf("expected %d transactions, got %d", len(expected.Transaction), len(block.Transaction))
+		}
+		for i, tx := range block.Transaction {
+			if tx.Hash != expected.Transaction[i].Hash || tx.Seq != expected.Transaction[i].Seq {
+				t.Fatalf("transaction %d is out of order", i)
+			}
+			if len(tx.Diagnostics) != len(expected.Transaction[i].Diagnostics) {
+				t.Fatalf("transaction %d has unexpected diagnostics", i)
+			}
+			if tx.Time != 1700000000 || tx.Slot != 1 {
+				t.Fatalf("transaction %d has unexpected slot or time", i)
+			}
+		}
+	}
+}
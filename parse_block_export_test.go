@@ -0,0 +1,120 @@
+package solanaparser
+
+import (
+	"bytes"
+	"encoding/csv"
+	"io"
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/exporter"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	"github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+)
+
+func newTestExportOutputs() map[string]io.Writer {
+	outputs := make(map[string]io.Writer)
+	for _, table := range exporter.Tables {
+		outputs[table] = &bytes.Buffer{}
+	}
+	return outputs
+}
+
+func TestExporter_CSV(t *testing.T) {
+	block := ParseBlock(1, newTestBlock(t, 4))
+	outputs := newTestExportOutputs()
+	w, err := exporter.NewCSVWriterTo(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	records, err := csv.NewReader(outputs[exporter.TableTransfers].(*bytes.Buffer)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	columns := exporter.Columns(&exporter.TransferRow{})
+	if len(records) != 5 || len(records[0]) != len(columns) || records[0][0] != "slot" {
+		t.Fatalf("unexpected transfers csv %v", records)
+	}
+	row := make(map[string]string)
+	for i, column := range records[0] {
+		row[column] = records[1][i]
+	}
+	if row["signature"] != block.Transaction[0].Hash.String() || row["path"] != "1" || row["amount"] != "100000" || row["ui_amount"] != "0.1" {
+		t.Fatalf("unexpected transfer row %v", row)
+	}
+	records, err = csv.NewReader(outputs[exporter.TableBalanceChanges].(*bytes.Buffer)).ReadAll()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(records) != 9 {
+		t.Fatalf("expected 8 balance changes, got %d", len(records)-1)
+	}
+}
+
+func TestExporter_Parquet(t *testing.T) {
+	block := ParseBlock(1, newTestBlock(t, 4))
+	outputs := newTestExportOutputs()
+	w, err := exporter.NewParquetWriterTo(outputs)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := w.WriteBlock(block); err != nil {
+		t.Fatal(err)
+	}
+	if err := w.Close(); err != nil {
+		t.Fatal(err)
+	}
+	data := outputs[exporter.TableTransfers].(*bytes.Buffer).Bytes()
+	pr, err := reader.NewParquetReader(buffer.NewBufferFileFromBytes(data), nil, 1)
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer pr.ReadStop()
+	if pr.GetNumRows() != 4 {
+		t.Fatalf("expected 4 rows, got %d", pr.GetNumRows())
+	}
+	columns := exporter.Columns(&exporter.TransferRow{})
+	schema := pr.Footer.GetSchema()
+	if len(schema) != len(columns)+1 {
+		t.Fatalf("expected %d schema elements, got %d", len(columns)+1, len(schema))
+	}
+	for i, column := range columns {
+		// the reader renames the schema elements to go names, the names in the file are the external names
+		if pr.SchemaHandler.GetExName(i+1) != column || schema[i+1].GetRepetitionType() != parquet.FieldRepetitionType_REQUIRED {
+			t.Fatalf("unexpected column %d %v", i, schema[i+1])
+		}
+	}
+	for _, chunk := range pr.Footer.GetRowGroups()[0].GetColumns() {
+		stats := chunk.GetMetaData().GetStatistics()
+		if stats == nil || stats.GetMinValue() == nil || stats.GetMaxValue() == nil || stats.GetNullCount() != 0 {
+			t.Fatalf("column %v has no statistics", chunk.GetMetaData().GetPathInSchema())
+		}
+	}
+
+	// required columns have no definition levels, every value is read back at level 0
+	row := make(map[string]interface{})
+	for i, column := range columns {
+		values, _, dls, err := pr.ReadColumnByIndex(int64(i), 4)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if len(values) != 4 || len(dls) != 4 {
+			t.Fatalf("column %s: expected 4 values, got %d", column, len(values))
+		}
+		for _, dl := range dls {
+			if dl != 0 {
+				t.Fatalf("column %s: unexpected definition level %d", column, dl)
+			}
+		}
+		row[column] = values[0]
+	}
+	if row["signature"] != block.Transaction[0].Hash.String() || row["path"] != "1" || row["amount"] != int64(100000) || row["ui_amount"] != "0.1" {
+		t.Fatalf("unexpected transfer row %v", row)
+	}
+}
@@ -0,0 +1,74 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/coverage"
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestCoverage_Report(t *testing.T) {
+	report := coverage.Build(program.DefaultRegistry)
+	classes := make(map[string]coverage.Class)
+	var token *coverage.Program
+	for _, p := range report.Programs {
+		for _, handler := range p.Handlers {
+			classes[p.Name+"."+handler.Name] = handler.Class
+		}
+		if p.Id == solana.TokenProgramID {
+			token = p
+		}
+	}
+	expected := map[string]coverage.Class{
+		"LifinityV2.ParseDepositAllTokenTypes": coverage.ClassLogOnly,
+		"LifinityV2.ParseSwap":                 coverage.ClassEvents,
+		"MeteoraDlmm.ParseClaimFee":            coverage.ClassIgnored,
+		"token.ParseTransfer":                  coverage.ClassEvents,
+	}
+	for name, class := range expected {
+		if classes[name] != class {
+			t.Fatalf("expected %s to be %s, got %s", name, class, classes[name])
+		}
+	}
+
+	report.CountBlock(newTestBlock(t, 3))
+	if token == nil || token.Hits != 3 || token.Unknown != 0 {
+		t.Fatal("unexpected token hits")
+	}
+	for _, handler := range token.Handlers {
+		if handler.Name == "ParseTransfer" && handler.Hits != 3 {
+			t.Fatalf("expected 3 transfers, got %d", handler.Hits)
+		}
+	}
+}
+
+func TestCoverage_ReportDiscriminatorLength(t *testing.T) {
+	report := coverage.Build(program.DefaultRegistry)
+	var system *coverage.Program
+	for _, p := range report.Programs {
+		if p.Id == solana.SystemProgramID {
+			system = p
+		}
+	}
+	from := solana.NewWallet().PublicKey()
+	// the system program uses a u32 index, a single byte 2 is not a transfer
+	transaction := newTestTransaction(t, from, solana.NewInstruction(solana.SystemProgramID, solana.AccountMetaSlice{solana.Meta(from).SIGNER()}, []byte{2}))
+	data, err := transaction.MarshalBinary()
+	if err != nil {
+		t.Fatal(err)
+	}
+	report.CountBlock(&rpc.GetBlockResult{Transactions: []rpc.TransactionWithMeta{{
+		Transaction: rpc.DataBytesOrJSONFromBytes(data),
+		Meta:        &rpc.TransactionMeta{},
+	}}})
+	if system == nil || system.Hits != 1 || system.Unknown != 1 {
+		t.Fatal("unexpected system hits")
+	}
+	for _, handler := range system.Handlers {
+		if handler.Hits != 0 {
+			t.Fatalf("unexpected hits of %s", handler.Name)
+		}
+	}
+}
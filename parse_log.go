@@ -0,0 +1,198 @@
+package solanaparser
+
+import (
+	"encoding/base64"
+	"errors"
+	"strconv"
+	"strings"
+
+	"github.com/blockchain-develop/solana-parser/log"
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+// logFrame is one program invocation rebuilt from the log messages
+type logFrame struct {
+	program      solana.PublicKey
+	depth        int
+	logs         []string
+	computeUnits uint64
+	success      bool
+	failed       bool
+	err          string
+	children     []*logFrame
+}
+
+// parseLogs rebuilds the invocation tree from the log messages,
+// it walks the "invoke [n]", "consumed", "success" & "failed" lines
+func parseLogs(messages []string) []*logFrame {
+	frames := make([]*logFrame, 0)
+	stack := make([]*logFrame, 0)
+	for _, message := range messages {
+		if message == "Log truncated" {
+			break
+		}
+		fields := strings.Fields(message)
+		var program solana.PublicKey
+		if len(fields) >= 3 && fields[0] == "Program" {
+			program, _ = solana.PublicKeyFromBase58(fields[1])
+		}
+		switch {
+		case !program.IsZero() && fields[2] == "invoke" && len(fields) == 4:
+			depth, _ := strconv.Atoi(strings.Trim(fields[3], "[]"))
+			frame := &logFrame{
+				program: program,
+				depth:   depth,
+			}
+			if len(stack) == 0 {
+				frames = append(frames, frame)
+			} else {
+				parent := stack[len(stack)-1]
+				parent.children = append(parent.children, frame)
+			}
+			stack = append(stack, frame)
+		case len(stack) == 0:
+			continue
+		case program == stack[len(stack)-1].program && fields[2] == "success":
+			stack[len(stack)-1].success = true
+			stack = stack[:len(stack)-1]
+		case program == stack[len(stack)-1].program && strings.HasPrefix(fields[2], "failed"):
+			frame := stack[len(stack)-1]
+			frame.failed = true
+			frame.err = strings.TrimSpace(strings.TrimPrefix(message[strings.Index(message, "failed"):], "failed:"))
+			stack = stack[:len(stack)-1]
+		case program == stack[len(stack)-1].program && fields[2] == "consumed" && len(fields) >= 4:
+			stack[len(stack)-1].computeUnits, _ = strconv.ParseUint(fields[3], 10, 64)
+		default:
+			frame := stack[len(stack)-1]
+			frame.logs = append(frame.logs, message)
+		}
+	}
+	return frames
+}
+
+// attachLogs matches the invocation tree with the instruction tree by program id,
+// instructions without invocation, such as precompiles, are skipped
+func attachLogs(instructions []*types.Instruction, frames []*logFrame) {
+	index := 0
+	for _, in := range instructions {
+		if index >= len(frames) {
+			return
+		}
+		frame := frames[index]
+		if frame.program != in.RawInstruction.ProgID {
+			continue
+		}
+		index++
+		in.Logs = frame.logs
+		in.ComputeUnits = frame.computeUnits
+		in.Success = frame.success
+		if frame.failed && !in.Failed {
+			in.Failed = true
+			in.Error = frame.err
+		}
+		attachLogs(in.Children, frame.children)
+	}
+}
+
+// fillStackHeights reconstructs missing stack heights of the inner instructions from the invocation depths,
+// inner instructions which do not match the invocation tree are left untouched and end up flat
+func fillStackHeights(instructions []solana.CompiledInstruction, innerInstructions []rpc.InnerInstruction, frames []*logFrame, meta *types.Meta) []rpc.InnerInstruction {
+	complete := true
+	for _, innerInstruction := range innerInstructions {
+		for _, instruction := range innerInstruction.Instructions {
+			if instruction.StackHeight == 0 {
+				complete = false
+			}
+		}
+	}
+	if complete || len(frames) == 0 {
+		return innerInstructions
+	}
+	// top level frames by instruction index
+	tops := make(map[int]*logFrame)
+	index := 0
+	for i, instruction := range instructions {
+		if index >= len(frames) {
+			break
+		}
+		if frames[index].program != meta.Accounts[instruction.ProgramIDIndex].PublicKey {
+			continue
+		}
+		tops[i] = frames[index]
+		index++
+	}
+	result := make([]rpc.InnerInstruction, len(innerInstructions))
+	for i, innerInstruction := range innerInstructions {
+		result[i] = innerInstruction
+		frame, ok := tops[int(innerInstruction.Index)]
+		if !ok {
+			continue
+		}
+		descendants := flattenFrames(frame.children, nil)
+		if len(descendants) != len(innerInstruction.Instructions) {
+			continue
+		}
+		filled := make([]solana.CompiledInstruction, len(innerInstruction.Instructions))
+		matched := true
+		for j, instruction := range innerInstruction.Instructions {
+			if meta.Accounts[instruction.ProgramIDIndex].PublicKey != descendants[j].program {
+				matched = false
+				break
+			}
+			filled[j] = instruction
+			filled[j].StackHeight = uint16(descendants[j].depth)
+		}
+		if matched {
+			result[i].Instructions = filled
+		}
+	}
+	return result
+}
+
+// flattenFrames lists the frames in invocation order
+func flattenFrames(frames []*logFrame, result []*logFrame) []*logFrame {
+	for _, frame := range frames {
+		result = append(result, frame)
+		result = flattenFrames(frame.children, result)
+	}
+	return result
+}
+
+// parseLogEvents decodes the anchor events emitted as "Program data:" lines by the instruction into its Receipt,
+// events which are not registered are skipped
+func (p *Parser) parseLogEvents(t *types.Transaction, in *types.Instruction) {
+	for _, message := range in.Logs {
+		if !strings.HasPrefix(message, "Program data: ") {
+			continue
+		}
+		// sol_log_data logs every slice in base64 separated by a space
+		data := make([]byte, 0)
+		var err error
+		for _, field := range strings.Fields(strings.TrimPrefix(message, "Program data: ")) {
+			var slice []byte
+			if slice, err = base64.StdEncoding.DecodeString(field); err != nil {
+				break
+			}
+			data = append(data, slice...)
+		}
+		var event types.Event
+		if err == nil {
+			err = recoverPanic(func() (err error) {
+				event, err = p.registry.DecodeEvent(in.RawInstruction.ProgID, data)
+				return err
+			})
+		}
+		if errors.Is(err, program.ErrEventNotFound) {
+			continue
+		}
+		if err != nil {
+			log.Logger.Error("anchor log event decode error", "err", err, "program", in.RawInstruction.ProgID.String(), "tx", t.Hash.String())
+			t.Diagnostics = append(t.Diagnostics, p.newDiagnostic(t, in, err))
+			continue
+		}
+		in.Receipt = append(in.Receipt, event)
+	}
+}
@@ -0,0 +1,54 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestParser_Registry(t *testing.T) {
+	registry := program.DefaultRegistry.Subset("token", "unknown")
+	if len(registry.Programs()) != 1 {
+		t.Fatalf("expected 1 program, got %d", len(registry.Programs()))
+	}
+	if id, ok := registry.Id("token"); !ok || id != solana.TokenProgramID || registry.Name(id) != "token" {
+		t.Fatal("unexpected token registration")
+	}
+	parser := NewParser(registry)
+
+	tt := newTestTokenTransfer(t, 100000)
+	tx := parser.ParseTransaction(0, tt.transaction, tt.meta)
+	if tx == nil || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("token transfer is not parsed")
+	}
+
+	from := solana.NewWallet().PublicKey()
+	to := solana.NewWallet().PublicKey()
+	transaction := newTestTransaction(t, from, system.NewTransferInstruction(1000, from, to).Build())
+	tx = parser.ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("system transfer should be an unknown instruction")
+	}
+	if _, ok := tx.Instructions[0].Event[0].(*types.UnknownInstruction); !ok {
+		t.Fatal("system transfer should not be parsed")
+	}
+	if tx = ParseTransaction(0, transaction, &rpc.TransactionMeta{}); len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("system transfer is not parsed by the default registry")
+	}
+
+	registry.Unregister(solana.TokenProgramID)
+	tx = parser.ParseTransaction(0, tt.transaction, tt.meta)
+	if tx == nil || len(tx.Instructions[0].Event) != 1 || len(tx.Diagnostics) != 0 {
+		t.Fatal("unregistered program should be skipped")
+	}
+	if _, ok := tx.Instructions[0].Event[0].(*types.UnknownInstruction); !ok {
+		t.Fatal("unregistered program should be an unknown instruction")
+	}
+	if _, ok := program.DefaultRegistry.Lookup(solana.TokenProgramID); !ok {
+		t.Fatal("subset should not change the default registry")
+	}
+}
@@ -0,0 +1,63 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/shopspring/decimal"
+)
+
+func TestTransaction_UiAmount(t *testing.T) {
+	tt := newTestTokenTransfer(t, 100000)
+	tx := ParseTransaction(0, tt.transaction, tt.meta)
+	if tx == nil || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	transfer := tx.Instructions[0].Event[0].(*types.Transfer)
+	if transfer.Decimals != 6 || !transfer.UiAmount.Equal(decimal.RequireFromString("0.1")) {
+		t.Fatalf("unexpected ui amount %d %s", transfer.Decimals, transfer.UiAmount)
+	}
+
+	from := solana.NewWallet().PublicKey()
+	to := solana.NewWallet().PublicKey()
+	tx = ParseTransaction(0, newTestTransaction(t, from, system.NewTransferInstruction(1500000000, from, to).Build()), &rpc.TransactionMeta{})
+	transfer = tx.Instructions[0].Event[0].(*types.Transfer)
+	if transfer.Decimals != 9 || !transfer.UiAmount.Equal(decimal.RequireFromString("1.5")) {
+		t.Fatalf("unexpected sol ui amount %d %s", transfer.Decimals, transfer.UiAmount)
+	}
+}
+
+func TestTransaction_UiAmountFromInstructions(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	mint := solana.NewWallet().PublicKey()
+	source := solana.NewWallet().PublicKey()
+	destination := solana.NewWallet().PublicKey()
+	other := solana.NewWallet().PublicKey()
+	// the mint to comes before the instruction which tells the decimals, the burn of another mint has no decimals
+	transaction := newTestTransaction(t, owner,
+		token.NewMintToInstruction(2500, mint, destination, owner, nil).Build(),
+		token.NewInitializeMint2Instruction(3, owner, owner, mint).Build(),
+		token.NewTransferCheckedInstruction(500, 3, source, mint, destination, owner, nil).Build(),
+		token.NewBurnInstruction(100, source, other, owner, nil).Build(),
+	)
+	tx := ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions) != 4 {
+		t.Fatal("invalid transaction")
+	}
+	mintTo, ok := tx.Instructions[0].Event[0].(*types.MintTo)
+	if !ok || mintTo.Decimals != 3 || !mintTo.UiAmount.Equal(decimal.RequireFromString("2.5")) {
+		t.Fatalf("unexpected mint to %+v", tx.Instructions[0].Event[0])
+	}
+	transfer, ok := tx.Instructions[2].Event[0].(*types.Transfer)
+	if !ok || transfer.Decimals != 3 || !transfer.UiAmount.Equal(decimal.RequireFromString("0.5")) {
+		t.Fatalf("unexpected transfer %+v", tx.Instructions[2].Event[0])
+	}
+	burn, ok := tx.Instructions[3].Event[0].(*types.Burn)
+	if !ok || burn.Decimals != 0 || !burn.UiAmount.IsZero() {
+		t.Fatalf("unexpected burn %+v", tx.Instructions[3].Event[0])
+	}
+}
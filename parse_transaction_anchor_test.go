@@ -0,0 +1,162 @@
+package solanaparser
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/anchor"
+	"github.com/blockchain-develop/solana-parser/program/raydium_cp"
+	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/pumpfun"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransaction_AnchorEvent(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	emitter := solana.NewWallet().PublicKey()
+	discriminator := anchor.EventDiscriminator("TestEvent")
+	program.RegisterEvent(emitter, discriminator, func(programId solana.PublicKey, data []byte) (types.Event, error) {
+		return &types.SwapEvent{InputAmount: uint64(data[0])}, nil
+	})
+	transaction := newTestTransaction(t, owner, solana.NewInstruction(emitter, solana.AccountMetaSlice{solana.Meta(owner)}, []byte{1}))
+	var emitterIndex uint16
+	for i, key := range transaction.Message.AccountKeys {
+		if key == emitter {
+			emitterIndex = uint16(i)
+		}
+	}
+	logData := append(append(anchor.Instruction_SelfCPILog[:], discriminator[:]...), 7)
+	unknownData := append(append(anchor.Instruction_SelfCPILog[:], 1, 2, 3, 4, 5, 6, 7, 8), 7)
+	meta := &rpc.TransactionMeta{
+		InnerInstructions: []rpc.InnerInstruction{{
+			Index: 0,
+			Instructions: []solana.CompiledInstruction{
+				{ProgramIDIndex: emitterIndex, Data: logData, StackHeight: 2},
+				{ProgramIDIndex: emitterIndex, Data: unknownData, StackHeight: 2},
+			},
+		}},
+	}
+
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Children) != 2 {
+		t.Fatal("invalid transaction")
+	}
+	in := tx.Instructions[0]
+	if len(in.Receipt) != 1 {
+		t.Fatalf("expected 1 receipt, got %d", len(in.Receipt))
+	}
+	if event, ok := in.Receipt[0].(*types.SwapEvent); !ok || event.InputAmount != 7 {
+		t.Fatalf("unexpected receipt %+v", in.Receipt[0])
+	}
+	if len(in.Children[0].Event) != 0 || len(tx.Diagnostics) != 0 {
+		t.Fatal("self cpi log should not be parsed as an instruction")
+	}
+}
+
+func TestTransaction_AnchorPumpTradeEvent(t *testing.T) {
+	buf := new(bytes.Buffer)
+	event := pumpfun.TradeEvent{
+		Mint:        solana.NewWallet().PublicKey(),
+		SolAmount:   1000,
+		TokenAmount: 2000,
+		IsBuy:       false,
+	}
+	if err := event.MarshalWithEncoder(ag_binary.NewBorshEncoder(buf)); err != nil {
+		t.Fatal(err)
+	}
+	discriminator := anchor.EventDiscriminator("TradeEvent")
+	data := append(discriminator[:], buf.Bytes()...)
+	decoded, err := program.DefaultRegistry.DecodeEvent(pumpfun.ProgramID, data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	sell, ok := decoded.(*types.MemeSellEvent)
+	if !ok || sell.ProgramId != pumpfun.ProgramID || sell.Mint != event.Mint || sell.SolAmount != 1000 || sell.TokenAmount != 2000 {
+		t.Fatalf("unexpected event %+v", decoded)
+	}
+}
+
+func TestTransaction_AnchorLogEvent(t *testing.T) {
+	nt := newTestNestedTransfer(t, 100000, 2)
+	buf := new(bytes.Buffer)
+	event := raydium_cp.SwapEvent{
+		PoolId:       solana.NewWallet().PublicKey(),
+		InputAmount:  100000,
+		OutputAmount: 5000,
+		BaseInput:    true,
+	}
+	if err := ag_binary.NewBorshEncoder(buf).Encode(&event); err != nil {
+		t.Fatal(err)
+	}
+	program.RegisterEvent(nt.program, raydium_cp.Event_Swap, raydium_cp.DecodeSwapEvent)
+	discriminator := raydium_cp.Event_Swap
+	data := base64.StdEncoding.EncodeToString(append(discriminator[:], buf.Bytes()...))
+	logs := nt.meta.LogMessages
+	nt.meta.LogMessages = append(append(append([]string{}, logs[:6]...), "Program data: "+data), logs[6:]...)
+
+	tx := ParseTransaction(0, nt.transaction, nt.meta)
+	if tx == nil || len(tx.Instructions) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	in := tx.Instructions[0]
+	if len(in.Receipt) != 1 || len(in.Children[0].Receipt) != 0 {
+		t.Fatalf("expected 1 receipt, got %d", len(in.Receipt))
+	}
+	swapEvent, ok := in.Receipt[0].(*types.CpSwapEvent)
+	if !ok || swapEvent.ProgramId != nt.program || swapEvent.PoolId != event.PoolId || swapEvent.InputAmount != 100000 || swapEvent.OutputAmount != 5000 || !swapEvent.BaseInput {
+		t.Fatalf("unexpected receipt %+v", in.Receipt[0])
+	}
+	if len(tx.Diagnostics) != 0 {
+		t.Fatal("unknown log events should be skipped")
+	}
+}
+
+func TestTransaction_AnchorEventRegistry(t *testing.T) {
+	emitter := solana.NewWallet().PublicKey()
+	alias := solana.NewWallet().PublicKey()
+	discriminator := anchor.EventDiscriminator("TestEvent")
+	newRegistry := func(amount uint64) *program.Registry {
+		registry := program.NewRegistry()
+		registry.Register(emitter, "Emitter", program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error { return nil })
+		registry.RegisterEvent(emitter, discriminator, func(programId solana.PublicKey, data []byte) (types.Event, error) {
+			return &types.SwapEvent{ProgramId: programId, InputAmount: amount}, nil
+		})
+		return registry
+	}
+	first, second := newRegistry(1), newRegistry(2)
+	if err := second.Alias(alias, emitter); err != nil {
+		t.Fatal(err)
+	}
+	data := append(discriminator[:], 7)
+	for _, test := range []struct {
+		registry  *program.Registry
+		programId solana.PublicKey
+		amount    uint64
+	}{
+		{first, emitter, 1},
+		{second, emitter, 2},
+		{second, alias, 2},
+		{second.Subset("Emitter"), alias, 2},
+		{first.ForCluster(program.Mainnet), emitter, 1},
+	} {
+		event, err := test.registry.DecodeEvent(test.programId, data)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if swapEvent := event.(*types.SwapEvent); swapEvent.InputAmount != test.amount || swapEvent.ProgramId != test.programId {
+			t.Fatalf("unexpected event %+v", swapEvent)
+		}
+	}
+	if _, err := first.DecodeEvent(alias, data); !errors.Is(err, program.ErrEventNotFound) {
+		t.Fatalf("events of another registry should not be found, got %v", err)
+	}
+	first.Unregister(emitter)
+	if _, err := first.DecodeEvent(emitter, data); !errors.Is(err, program.ErrEventNotFound) {
+		t.Fatalf("events of an unregistered program should not be found, got %v", err)
+	}
+}
@@ -0,0 +1,68 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/raydium_cp"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestParser_Cluster(t *testing.T) {
+	devnet := program.DefaultRegistry.ForCluster(program.Devnet)
+	cp := program.Devnet.Programs[raydium_cp.ProgramName][0]
+	if devnet.Name(cp) != raydium_cp.ProgramName || devnet.Canonical(cp) != raydium_cp.ProgramID {
+		t.Fatal("devnet raydium cp is not an alias")
+	}
+	if _, ok := program.DefaultRegistry.Lookup(cp); ok {
+		t.Fatal("cluster should not change the default registry")
+	}
+	if len(devnet.Programs()) != len(program.DefaultRegistry.Programs()) {
+		t.Fatal("aliases should not be listed as programs")
+	}
+
+	// a redeployed copy of the token program on a local validator
+	fork := solana.NewWallet().PublicKey()
+	local := program.Mainnet.With("token", fork)
+	registry := program.DefaultRegistry.ForCluster(local)
+	owner := solana.NewWallet().PublicKey()
+	transfer := token.NewTransferInstruction(100, solana.NewWallet().PublicKey(), solana.NewWallet().PublicKey(), owner, nil).Build()
+	data, err := transfer.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	transaction := newTestTransaction(t, owner, solana.NewInstruction(fork, transfer.Accounts(), data))
+
+	tx := NewParser(registry).ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	if event, ok := tx.Instructions[0].Event[0].(*types.Transfer); !ok || event.Amount != 100 {
+		t.Fatalf("token transfer of the fork is not parsed, got %+v", tx.Instructions[0].Event[0])
+	}
+
+	registry.Unregister(fork)
+	if _, ok := registry.Lookup(fork); ok {
+		t.Fatal("alias is not unregistered")
+	}
+	if _, ok := registry.Lookup(solana.TokenProgramID); !ok {
+		t.Fatal("unregistering an alias should keep the program")
+	}
+	if err := registry.Alias(fork, solana.NewWallet().PublicKey()); err == nil {
+		t.Fatal("alias of an unregistered program should fail")
+	}
+
+	// a new registration of a program drops the aliases of the previous registration
+	devnet.Register(raydium_cp.ProgramID, raydium_cp.ProgramName, program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error {
+		return nil
+	})
+	if _, ok := devnet.Lookup(cp); ok {
+		t.Fatal("alias of the previous registration is kept")
+	}
+	if registration, ok := devnet.Lookup(raydium_cp.ProgramID); !ok || len(registration.Aliases) != 0 {
+		t.Fatal("unexpected registration of the program")
+	}
+}
@@ -0,0 +1,63 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/raydium_cp"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransaction_Diagnostic_TokenAccountMissing(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	source := solana.NewWallet().PublicKey()
+	destination := solana.NewWallet().PublicKey()
+	transaction := newTestTransaction(t, owner, token.NewTransferInstruction(100, source, destination, owner, nil).Build())
+
+	tx := ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil {
+		t.Fatal("invalid transaction")
+	}
+	if len(tx.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(tx.Diagnostics))
+	}
+	diagnostic := tx.Diagnostics[0]
+	if diagnostic.Kind != types.DiagnosticTokenAccountMissing {
+		t.Fatalf("unexpected diagnostic kind %s", diagnostic.Kind)
+	}
+	if diagnostic.Program != solana.TokenProgramID || len(diagnostic.Path) != 1 || diagnostic.Path[0] != 1 {
+		t.Fatalf("unexpected diagnostic %+v", diagnostic)
+	}
+	if diagnostic.Discriminator != "03" {
+		t.Fatalf("unexpected discriminator %s", diagnostic.Discriminator)
+	}
+	if len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("partial transfer event is missing")
+	}
+}
+
+func TestTransaction_Diagnostic_Discriminator(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	swap := raydium_cp.Instruction_SwapBaseInput
+	for _, test := range []struct {
+		program       solana.PublicKey
+		data          []byte
+		discriminator string
+	}{
+		// the system program uses a u32 index, the lamports of the transfer are truncated
+		{solana.SystemProgramID, []byte{2, 0, 0, 0, 1, 2}, "02000000"},
+		// anchor programs use 8 bytes
+		{raydium_cp.ProgramID, append(swap[:], 1, 2), "8fbe5adac41e33de"},
+	} {
+		instruction := solana.NewInstruction(test.program, solana.AccountMetaSlice{solana.Meta(owner).SIGNER()}, test.data)
+		tx := ParseTransaction(0, newTestTransaction(t, owner, instruction), &rpc.TransactionMeta{})
+		if tx == nil || len(tx.Diagnostics) != 1 {
+			t.Fatalf("%s: expected 1 diagnostic", test.program)
+		}
+		if diagnostic := tx.Diagnostics[0]; diagnostic.Kind != types.DiagnosticDecodeFailure || diagnostic.Discriminator != test.discriminator {
+			t.Fatalf("%s: unexpected diagnostic %+v", test.program, diagnostic)
+		}
+	}
+}
@@ -0,0 +1,84 @@
+package solanaparser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/jupiter"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransaction_Failed(t *testing.T) {
+	from := solana.NewWallet().PublicKey()
+	to := solana.NewWallet().PublicKey()
+	transaction := newTestTransaction(t, from,
+		system.NewTransferInstruction(1000, from, to).Build(),
+		system.NewTransferInstruction(2000, from, to).Build(),
+	)
+	meta := &rpc.TransactionMeta{}
+	if err := json.Unmarshal([]byte(`{"InstructionError":[1,{"Custom":1}]}`), &meta.Err); err != nil {
+		t.Fatal(err)
+	}
+
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil || !tx.Failed || len(tx.Instructions) != 0 {
+		t.Fatal("failed transaction should be ignored by default")
+	}
+
+	tx = ParseTransaction(0, transaction, meta, WithFailedTransactions())
+	if tx == nil || !tx.Failed || len(tx.Instructions) != 2 {
+		t.Fatal("failed transaction should be decoded")
+	}
+	if tx.Instructions[0].Failed || !tx.Instructions[1].Failed {
+		t.Fatal("unexpected failed instruction")
+	}
+	if tx.Instructions[1].Error != `{"Custom":1}` {
+		t.Fatalf("unexpected instruction error %s", tx.Instructions[1].Error)
+	}
+	if tx.Instructions[1].ParsedInstruction == nil || len(tx.Instructions[1].Event) != 1 {
+		t.Fatal("failed instruction is not decoded")
+	}
+}
+
+func TestTransaction_FailedJupiterRoute(t *testing.T) {
+	user := solana.NewWallet().PublicKey()
+	keys := make([]solana.PublicKey, 6)
+	for i := range keys {
+		keys[i] = solana.NewWallet().PublicKey()
+	}
+	routePlan := []jupiter.RoutePlanStep{
+		{Swap: &jupiter.Swap{Value: jupiter.SwapRaydiumTuple{}}, Percent: 100, InputIndex: 0, OutputIndex: 1},
+		{Swap: &jupiter.Swap{Value: jupiter.SwapRaydiumClmmTuple{}}, Percent: 100, InputIndex: 1, OutputIndex: 2},
+	}
+	route := jupiter.NewRouteInstruction(routePlan, 1000, 990, 50, 0,
+		solana.TokenProgramID, user, keys[0], keys[1], keys[2], keys[3], keys[4], keys[5], jupiter.ProgramID).Build()
+	transaction := newTestTransaction(t, user, route)
+	meta := &rpc.TransactionMeta{}
+	if err := json.Unmarshal([]byte(`{"InstructionError":[0,{"Custom":6001}]}`), &meta.Err); err != nil {
+		t.Fatal(err)
+	}
+
+	// the swaps of the failed route have no inner instructions
+	tx := ParseTransaction(0, transaction, meta, WithFailedTransactions())
+	if tx == nil || len(tx.Instructions) != 1 || !tx.Instructions[0].Failed {
+		t.Fatal("failed transaction should be decoded")
+	}
+	if len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("expected a route event")
+	}
+	event, ok := tx.Instructions[0].Event[0].(*types.Route)
+	if !ok || event.User != user || len(event.RouteSteps) != 2 {
+		t.Fatalf("unexpected event %+v", tx.Instructions[0].Event[0])
+	}
+	for _, step := range event.RouteSteps {
+		if step.RoutePlan == nil || step.Swap != nil {
+			t.Fatalf("unexpected route step %+v", step)
+		}
+	}
+	if len(tx.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics %+v", tx.Diagnostics)
+	}
+}
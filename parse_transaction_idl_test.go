@@ -0,0 +1,147 @@
+package solanaparser
+
+import (
+	"crypto/sha256"
+	"encoding/binary"
+	"fmt"
+	"math/big"
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/idl"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+const testIdl = `{
+  "address": "%s",
+  "metadata": {"name": "test_dex", "version": "0.1.0", "spec": "0.1.0"},
+  "instructions": [{
+    "name": "swap",
+    "discriminator": [1, 2, 3, 4, 5, 6, 7, 8],
+    "accounts": [
+      {"name": "user", "writable": true, "signer": true},
+      {"name": "pool", "accounts": [{"name": "state", "writable": true}, {"name": "vault"}]}
+    ],
+    "args": [
+      {"name": "amount_in", "type": "u64"},
+      {"name": "params", "type": {"defined": {"name": "SwapParams"}}}
+    ]
+  }],
+  "types": [
+    {"name": "SwapParams", "type": {"kind": "struct", "fields": [
+      {"name": "min_out", "type": "u64"},
+      {"name": "side", "type": {"defined": {"name": "Side"}}},
+      {"name": "path", "type": {"vec": "pubkey"}}
+    ]}},
+    {"name": "Side", "type": {"kind": "enum", "variants": [{"name": "Buy"}, {"name": "Sell"}]}}
+  ]
+}`
+
+const testLegacyIdl = `{
+  "version": "0.1.0",
+  "name": "legacy_pool",
+  "instructions": [{
+    "name": "createPool",
+    "accounts": [{"name": "payer", "isMut": true, "isSigner": true}],
+    "args": [
+      {"name": "fee", "type": "u16"},
+      {"name": "label", "type": "string"},
+      {"name": "authority", "type": {"option": "publicKey"}},
+      {"name": "liquidity", "type": "u128"}
+    ]
+  }],
+  "metadata": {"address": "%s"}
+}`
+
+func TestTransaction_Idl(t *testing.T) {
+	dex := solana.NewWallet().PublicKey()
+	legacy := solana.NewWallet().PublicKey()
+	user := solana.NewWallet().PublicKey()
+	state := solana.NewWallet().PublicKey()
+	vault := solana.NewWallet().PublicKey()
+	hop := solana.NewWallet().PublicKey()
+
+	registry := program.NewRegistry()
+	dexIdl, err := idl.Load([]byte(fmt.Sprintf(testIdl, dex)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := idl.Register(registry, dexIdl, program.Swap, 1); err != nil {
+		t.Fatal(err)
+	}
+	legacyIdl, err := idl.Load([]byte(fmt.Sprintf(testLegacyIdl, legacy)))
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := idl.Register(registry, legacyIdl, program.Swap, 1); err != nil {
+		t.Fatal(err)
+	}
+	if registry.Name(dex) != "test_dex" || registry.Name(legacy) != "legacy_pool" {
+		t.Fatal("unexpected registrations")
+	}
+
+	swapData := []byte{1, 2, 3, 4, 5, 6, 7, 8}
+	swapData = binary.LittleEndian.AppendUint64(swapData, 1000)
+	swapData = binary.LittleEndian.AppendUint64(swapData, 900)
+	swapData = append(swapData, 1)
+	swapData = binary.LittleEndian.AppendUint32(swapData, 1)
+	swapData = append(swapData, hop[:]...)
+
+	createData := append([]byte{}, sighash("global:create_pool")...)
+	createData = binary.LittleEndian.AppendUint16(createData, 30)
+	createData = binary.LittleEndian.AppendUint32(createData, 3)
+	createData = append(createData, "SOL"...)
+	createData = append(createData, 0)
+	createData = append(createData, 1, 0, 0, 0, 0, 0, 0, 0, 1, 0, 0, 0, 0, 0, 0, 0)
+
+	transaction := newTestTransaction(t, user,
+		solana.NewInstruction(dex, solana.AccountMetaSlice{solana.Meta(user).SIGNER().WRITE(), solana.Meta(state).WRITE(), solana.Meta(vault)}, swapData),
+		solana.NewInstruction(legacy, solana.AccountMetaSlice{solana.Meta(user).SIGNER().WRITE()}, createData),
+		solana.NewInstruction(legacy, solana.AccountMetaSlice{solana.Meta(user).SIGNER().WRITE()}, []byte{9, 9, 9, 9, 9, 9, 9, 9}),
+	)
+	tx := NewParser(registry).ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions) != 3 {
+		t.Fatal("invalid transaction")
+	}
+
+	swap, ok := tx.Instructions[0].ParsedInstruction.(*types.DecodedInstruction)
+	if !ok || swap.Name != "swap" || swap.ProgramId != dex {
+		t.Fatalf("unexpected instruction %+v", tx.Instructions[0].ParsedInstruction)
+	}
+	if swap.Args["amount_in"] != uint64(1000) {
+		t.Fatalf("unexpected amount_in %v", swap.Args["amount_in"])
+	}
+	params := swap.Args["params"].(map[string]interface{})
+	if params["min_out"] != uint64(900) || params["side"] != "Sell" {
+		t.Fatalf("unexpected params %v", params)
+	}
+	if path := params["path"].([]interface{}); len(path) != 1 || path[0] != hop {
+		t.Fatalf("unexpected path %v", path)
+	}
+	if swap.Accounts["user"] != user || swap.Accounts["pool.state"] != state || swap.Accounts["pool.vault"] != vault {
+		t.Fatalf("unexpected accounts %v", swap.Accounts)
+	}
+
+	create, ok := tx.Instructions[1].ParsedInstruction.(*types.DecodedInstruction)
+	if !ok || create.Name != "createPool" || create.Accounts["payer"] != user {
+		t.Fatalf("unexpected instruction %+v", tx.Instructions[1].ParsedInstruction)
+	}
+	if create.Args["fee"] != uint16(30) || create.Args["label"] != "SOL" || create.Args["authority"] != nil {
+		t.Fatalf("unexpected args %v", create.Args)
+	}
+	liquidity := new(big.Int).Add(new(big.Int).Lsh(big.NewInt(1), 64), big.NewInt(1))
+	if create.Args["liquidity"].(*big.Int).Cmp(liquidity) != 0 {
+		t.Fatalf("unexpected liquidity %v", create.Args["liquidity"])
+	}
+
+	if len(tx.Diagnostics) != 1 || tx.Diagnostics[0].Kind != types.DiagnosticParserNotFound || tx.Diagnostics[0].Path[0] != 3 {
+		t.Fatal("expected a parser not found diagnostic for the unknown discriminator")
+	}
+}
+
+func sighash(preimage string) []byte {
+	sum := sha256.Sum256([]byte(preimage))
+	return sum[:8]
+}
@@ -0,0 +1,66 @@
+package solanaparser
+
+import (
+	"bytes"
+	"encoding/json"
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+)
+
+func TestTransaction_JSON(t *testing.T) {
+	tt := newTestTokenTransfer(t, 100000)
+	tx := ParseTransaction(0, tt.transaction, tt.meta)
+	if tx == nil || len(tx.Instructions[0].Event) != 1 || len(tx.Actions) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	tx.Instructions[0].Receipt = []types.Event{&types.SwapEvent{ProgramId: solana.TokenProgramID, InputAmount: 7}}
+	data, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !bytes.Contains(data, []byte(`{"type":"transfer",`)) || !bytes.Contains(data, []byte(`{"type":"swap_event",`)) {
+		t.Fatalf("events have no type: %s", data)
+	}
+
+	var decoded types.Transaction
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	if decoded.Hash != tx.Hash || len(decoded.Instructions) != 1 {
+		t.Fatal("invalid decoded transaction")
+	}
+	in := decoded.Instructions[0]
+	transfer, ok := in.Event[0].(*types.Transfer)
+	if !ok || !equalTransfer(transfer, tx.Instructions[0].Event[0].(*types.Transfer)) {
+		t.Fatalf("unexpected event %+v", in.Event[0])
+	}
+	if transfer.Program() != solana.TokenProgramID {
+		t.Fatal("transfer program is not the token program")
+	}
+	if swapEvent, ok := in.Receipt[0].(*types.SwapEvent); !ok || swapEvent.InputAmount != 7 {
+		t.Fatalf("unexpected receipt %+v", in.Receipt[0])
+	}
+	if action, ok := decoded.Actions[0].Action.(*types.Transfer); !ok || !equalTransfer(action, transfer) {
+		t.Fatalf("unexpected action %+v", decoded.Actions[0].Action)
+	}
+	if !decoded.Meta.TokenPostBalance[tt.destination].Equal(tx.Meta.TokenPostBalance[tt.destination]) {
+		t.Fatal("meta is not decoded")
+	}
+}
+
+func equalTransfer(a *types.Transfer, b *types.Transfer) bool {
+	return a.ProgramId == b.ProgramId && a.Mint == b.Mint && a.From == b.From && a.To == b.To &&
+		a.Amount == b.Amount && a.Decimals == b.Decimals && a.UiAmount.Equal(b.UiAmount)
+}
+
+func TestTransaction_JSONUnknownKind(t *testing.T) {
+	if _, err := types.UnmarshalEvent([]byte(`{"type":"unknown_kind","Amount":1}`)); err == nil {
+		t.Fatal("unknown kind should fail")
+	}
+	event, err := types.UnmarshalEvent([]byte(`null`))
+	if err != nil || event != nil {
+		t.Fatal("null should be a nil event")
+	}
+}
@@ -0,0 +1,142 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+type testNestedTransfer struct {
+	*testTokenTransfer
+	program solana.PublicKey
+}
+
+// newTestNestedTransfer builds an unknown program instruction which invokes a token transfer with the given stack height
+func newTestNestedTransfer(t *testing.T, amount uint64, stackHeight uint16) *testNestedTransfer {
+	tt := newTestTokenTransfer(t, amount)
+	nt := &testNestedTransfer{
+		testTokenTransfer: tt,
+		program:           solana.NewWallet().PublicKey(),
+	}
+	transfer := token.NewTransferInstruction(amount, tt.source, tt.destination, tt.owner, nil).Build()
+	data, err := transfer.Data()
+	if err != nil {
+		t.Fatal(err)
+	}
+	accounts := append(solana.AccountMetaSlice{}, transfer.Accounts()...)
+	accounts = append(accounts, solana.Meta(solana.TokenProgramID))
+	tt.transaction = newTestTransaction(t, tt.owner, solana.NewInstruction(nt.program, accounts, []byte{1}))
+	index := func(key solana.PublicKey) uint16 {
+		for i, k := range tt.transaction.Message.AccountKeys {
+			if k == key {
+				return uint16(i)
+			}
+		}
+		t.Fatalf("account %s not found", key)
+		return 0
+	}
+	// account order changed with the new transaction, rebuild the token balances
+	for i, balance := range tt.meta.PreTokenBalances {
+		owner := *balance.Owner
+		account := tt.source
+		if owner == tt.receiver {
+			account = tt.destination
+		}
+		tt.meta.PreTokenBalances[i].AccountIndex = index(account)
+		tt.meta.PostTokenBalances[i].AccountIndex = index(account)
+	}
+	tt.meta.PreBalances = make([]uint64, len(tt.transaction.Message.AccountKeys))
+	tt.meta.PostBalances = make([]uint64, len(tt.transaction.Message.AccountKeys))
+	tt.meta.InnerInstructions = []rpc.InnerInstruction{{
+		Index: 0,
+		Instructions: []solana.CompiledInstruction{{
+			ProgramIDIndex: index(solana.TokenProgramID),
+			Accounts:       []uint16{index(tt.source), index(tt.destination), index(tt.owner)},
+			Data:           data,
+			StackHeight:    stackHeight,
+		}},
+	}}
+	tt.meta.LogMessages = []string{
+		"Program " + nt.program.String() + " invoke [1]",
+		"Program log: Instruction: Swap",
+		"Program " + solana.TokenProgramID.String() + " invoke [2]",
+		"Program log: Instruction: Transfer",
+		"Program " + solana.TokenProgramID.String() + " consumed 4645 of 180000 compute units",
+		"Program " + solana.TokenProgramID.String() + " success",
+		"Program data: AQID",
+		"Program " + nt.program.String() + " consumed 20000 of 200000 compute units",
+		"Program " + nt.program.String() + " success",
+	}
+	return nt
+}
+
+func TestTransaction_Logs(t *testing.T) {
+	nt := newTestNestedTransfer(t, 100000, 2)
+	tx := ParseTransaction(0, nt.transaction, nt.meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Children) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	outer := tx.Instructions[0]
+	if !outer.Success || outer.ComputeUnits != 20000 || len(outer.Logs) != 2 {
+		t.Fatalf("unexpected outer instruction log %v %d", outer.Logs, outer.ComputeUnits)
+	}
+	if outer.Logs[1] != "Program data: AQID" {
+		t.Fatalf("unexpected outer instruction log %s", outer.Logs[1])
+	}
+	inner := outer.Children[0]
+	if !inner.Success || inner.ComputeUnits != 4645 || len(inner.Logs) != 1 {
+		t.Fatalf("unexpected inner instruction log %v %d", inner.Logs, inner.ComputeUnits)
+	}
+}
+
+func TestTransaction_LogsFailed(t *testing.T) {
+	frames := parseLogs([]string{
+		"Program ComputeBudget111111111111111111111111111111 invoke [1]",
+		"Program ComputeBudget111111111111111111111111111111 success",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA invoke [1]",
+		"Program log: Error: insufficient funds",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA consumed 3000 of 200000 compute units",
+		"Program TokenkegQfeZyiNwAJbNbGKPFXCWuBvf9Ss623VQ5DA failed: custom program error: 0x1",
+	})
+	if len(frames) != 2 || !frames[0].success || frames[1].success {
+		t.Fatal("unexpected frames")
+	}
+	if !frames[1].failed || frames[1].err != "custom program error: 0x1" || frames[1].computeUnits != 3000 {
+		t.Fatalf("unexpected failed frame %s", frames[1].err)
+	}
+}
+
+func TestTransaction_StackHeightFromLogs(t *testing.T) {
+	nt := newTestNestedTransfer(t, 100000, 0)
+	inner := nt.meta.InnerInstructions[0].Instructions[0]
+	nt.meta.InnerInstructions[0].Instructions = []solana.CompiledInstruction{inner, inner}
+	token := solana.TokenProgramID.String()
+	nt.meta.LogMessages = []string{
+		"Program " + nt.program.String() + " invoke [1]",
+		"Program " + token + " invoke [2]",
+		"Program " + token + " invoke [3]",
+		"Program " + token + " success",
+		"Program " + token + " success",
+		"Program " + nt.program.String() + " success",
+	}
+	tx := ParseTransaction(0, nt.transaction, nt.meta)
+	if tx == nil || len(tx.Instructions) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	outer := tx.Instructions[0]
+	if len(outer.Children) != 1 || len(outer.Children[0].Children) != 1 {
+		t.Fatal("inner instructions are not nested by the log depth")
+	}
+	if nt.meta.InnerInstructions[0].Instructions[1].StackHeight != 0 {
+		t.Fatal("meta should not be modified")
+	}
+
+	// truncated logs fall back to flat inner instructions
+	nt.meta.LogMessages = append(nt.meta.LogMessages[:2], "Log truncated")
+	tx = ParseTransaction(0, nt.transaction, nt.meta)
+	if tx == nil || len(tx.Instructions[0].Children) != 2 {
+		t.Fatal("inner instructions should be flat")
+	}
+}
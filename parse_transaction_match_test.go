@@ -0,0 +1,222 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/solfi"
+	"github.com/gagliardetto/solana-go/programs/stable_swap"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/programs/whirlpool"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransaction_MatchTransfers(t *testing.T) {
+	dex := solana.NewWallet().PublicKey()
+	user := solana.NewWallet().PublicKey()
+	mintA := solana.NewWallet().PublicKey()
+	mintB := solana.NewWallet().PublicKey()
+	source := solana.NewWallet().PublicKey()
+	vault := solana.NewWallet().PublicKey()
+	var byMint *types.Transfer
+	registry := program.DefaultRegistry.Subset("token")
+	registry.Register(dex, "TestDex", program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error {
+		matcher := program.NewTransferMatcher(in)
+		swap := &types.Swap{Dex: in.RawInstruction.ProgID}
+		swap.InputTransfer = matcher.Match(types.TransferMatch{Account: vault, AnyMint: true, Direction: types.TransferIn})
+		swap.OutputTransfer = matcher.Match(types.TransferMatch{Account: vault, AnyMint: true, Direction: types.TransferIn}, types.TransferMatch{Account: source, AnyMint: true, Direction: types.TransferOut})
+		in.Event = []types.Event{swap}
+		byMint = program.NewTransferMatcher(in).Match(types.TransferMatch{Account: vault, Mint: mintB, Direction: types.TransferIn})
+		return matcher.Err()
+	})
+	// the fee goes into the same vault as the input
+	transaction, meta := newTestInnerTransaction(t, user, dex,
+		token.NewTransferCheckedInstruction(1000, 6, source, mintA, vault, user, nil).Build(),
+		token.NewTransferCheckedInstruction(10, 6, source, mintB, vault, user, nil).Build(),
+	)
+
+	tx := NewParser(registry).ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	if candidates := tx.Instructions[0].MatchTransfers(types.TransferMatch{Account: vault, AnyMint: true, Direction: types.TransferIn}); len(candidates) != 2 {
+		t.Fatalf("expected 2 candidates, got %d", len(candidates))
+	}
+	swap := tx.Instructions[0].Event[0].(*types.Swap)
+	if swap.InputTransfer != nil || swap.OutputTransfer != nil {
+		t.Fatal("ambiguous matches should be left unset")
+	}
+	if byMint == nil || byMint.Amount != 10 {
+		t.Fatal("the mint should select the fee transfer")
+	}
+	// one diagnostic per ambiguous lookup, whatever the number of matches of the lookup
+	if len(tx.Diagnostics) != 2 {
+		t.Fatalf("expected 2 ambiguous transfer diagnostics, got %+v", tx.Diagnostics)
+	}
+	for _, diagnostic := range tx.Diagnostics {
+		if diagnostic.Kind != types.DiagnosticAmbiguousTransfer || diagnostic.Program != dex {
+			t.Fatalf("unexpected diagnostic %+v", diagnostic)
+		}
+	}
+}
+
+func TestTransaction_MatchTransfersUnknownMint(t *testing.T) {
+	dex := solana.NewWallet().PublicKey()
+	user := solana.NewWallet().PublicKey()
+	mint := solana.NewWallet().PublicKey()
+	source := solana.NewWallet().PublicKey()
+	vault := solana.NewWallet().PublicKey()
+	registry := program.DefaultRegistry.Subset("token")
+	registry.Register(dex, "TestDex", program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error {
+		matcher := program.NewTransferMatcher(in)
+		swap := &types.Swap{Dex: in.RawInstruction.ProgID}
+		swap.InputTransfer = matcher.ToAccount(meta, vault)
+		in.Event = []types.Event{swap}
+		return matcher.Err()
+	})
+	// the vault has no token balance, so the mint of the vault is unknown
+	transaction, meta := newTestInnerTransaction(t, user, dex,
+		token.NewTransferCheckedInstruction(1000, 6, source, mint, vault, user, nil).Build(),
+	)
+
+	tx := NewParser(registry).ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	if swap := tx.Instructions[0].Event[0].(*types.Swap); swap.InputTransfer != nil {
+		t.Fatal("an unknown mint should not match any mint")
+	}
+	if len(tx.Diagnostics) != 1 || tx.Diagnostics[0].Kind != types.DiagnosticTokenAccountMissing {
+		t.Fatalf("expected a token account missing diagnostic, got %+v", tx.Diagnostics)
+	}
+}
+
+func TestTransaction_MatchTransfersWhirlpoolTwoHopSwapV2(t *testing.T) {
+	keys := make([]solana.PublicKey, 24)
+	for i := range keys {
+		keys[i] = solana.NewWallet().PublicKey()
+	}
+	whirlpoolOne, whirlpoolTwo := keys[0], keys[1]
+	mintInput, mintIntermediate, mintOutput := keys[2], keys[3], keys[4]
+	userInput, vaultOneInput, vaultOneIntermediate, vaultTwoIntermediate, vaultTwoOutput, userOutput := keys[5], keys[6], keys[7], keys[8], keys[9], keys[10]
+	user := keys[11]
+	outer := whirlpool.NewTwoHopSwapV2Instruction(1000, 1, true, true, false, ag_binary.Uint128{}, ag_binary.Uint128{}, whirlpool.RemainingAccountsInfo{},
+		whirlpoolOne, whirlpoolTwo, mintInput, mintIntermediate, mintOutput,
+		solana.TokenProgramID, solana.TokenProgramID, solana.TokenProgramID,
+		userInput, vaultOneInput, vaultOneIntermediate, vaultTwoIntermediate, vaultTwoOutput, userOutput, user,
+		keys[12], keys[13], keys[14], keys[15], keys[16], keys[17], keys[18], keys[19], solana.MemoProgramID,
+	).Build()
+	transaction, meta := newTestInvokeTransaction(t, user, outer,
+		token.NewTransferCheckedInstruction(1000, 6, userInput, mintInput, vaultOneInput, user, nil).Build(),
+		// a transfer of another mint into the input vault is told apart by the mint of the pool
+		token.NewTransferCheckedInstruction(3, 6, userInput, mintIntermediate, vaultOneInput, user, nil).Build(),
+		token.NewTransferCheckedInstruction(500, 6, vaultOneIntermediate, mintIntermediate, vaultTwoIntermediate, whirlpoolOne, nil).Build(),
+		token.NewTransferCheckedInstruction(200, 9, vaultTwoOutput, mintOutput, userOutput, whirlpoolTwo, nil).Build(),
+	)
+
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	swap, ok := tx.Instructions[0].Event[0].(*types.Swap)
+	if !ok {
+		t.Fatalf("unexpected event %+v", tx.Instructions[0].Event[0])
+	}
+	if swap.InputTransfer == nil || swap.InputTransfer.Amount != 1000 || swap.InputTransfer.To != vaultOneInput {
+		t.Fatalf("unexpected input transfer %+v", swap.InputTransfer)
+	}
+	if swap.OutputTransfer == nil || swap.OutputTransfer.Amount != 200 || swap.OutputTransfer.From != vaultTwoOutput {
+		t.Fatalf("unexpected output transfer %+v", swap.OutputTransfer)
+	}
+	if len(tx.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics %+v", tx.Diagnostics)
+	}
+}
+
+func TestTransaction_MatchTransfersStableSwapV2(t *testing.T) {
+	keys := make([]solana.PublicKey, 12)
+	for i := range keys {
+		keys[i] = solana.NewWallet().PublicKey()
+	}
+	user, mintIn, mintOut := keys[0], keys[1], keys[2]
+	userTokenIn, userTokenOut, vaultTokenIn, vaultTokenOut, beneficiaryTokenOut := keys[3], keys[4], keys[5], keys[6], keys[7]
+	pool, withdrawAuthority := keys[8], keys[9]
+	outer := stable_swap.NewSwapV2Instruction(1000, 1, user, mintIn, mintOut, userTokenIn, userTokenOut, vaultTokenIn, vaultTokenOut, beneficiaryTokenOut,
+		pool, withdrawAuthority, keys[10], keys[11], stable_swap.ProgramID, solana.TokenProgramID, solana.Token2022ProgramID,
+	).Build()
+	transaction, meta := newTestInvokeTransaction(t, user, outer,
+		token.NewTransferCheckedInstruction(1000, 6, userTokenIn, mintIn, vaultTokenIn, user, nil).Build(),
+		token.NewTransferCheckedInstruction(990, 6, vaultTokenOut, mintOut, userTokenOut, withdrawAuthority, nil).Build(),
+		token.NewTransferCheckedInstruction(2, 6, vaultTokenOut, mintOut, beneficiaryTokenOut, withdrawAuthority, nil).Build(),
+	)
+
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	swap, ok := tx.Instructions[0].Event[0].(*types.Swap)
+	if !ok {
+		t.Fatalf("unexpected event %+v", tx.Instructions[0].Event[0])
+	}
+	if swap.InputTransfer == nil || swap.InputTransfer.Amount != 1000 || swap.InputTransfer.From != userTokenIn {
+		t.Fatalf("unexpected input transfer %+v", swap.InputTransfer)
+	}
+	if swap.OutputTransfer == nil || swap.OutputTransfer.Amount != 990 || swap.OutputTransfer.To != userTokenOut {
+		t.Fatalf("unexpected output transfer %+v", swap.OutputTransfer)
+	}
+	if swap.Source != types.AmountSourceTransfer || swap.AmountIn != 1000 || swap.AmountOut != 990 {
+		t.Fatalf("unexpected swap amounts %+v", swap.SwapAmounts)
+	}
+	if len(tx.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics %+v", tx.Diagnostics)
+	}
+}
+
+func TestTransaction_MatchTransfersSolfiSwap(t *testing.T) {
+	keys := make([]solana.PublicKey, 8)
+	for i := range keys {
+		keys[i] = solana.NewWallet().PublicKey()
+	}
+	user, pair, mintA, mintB := keys[0], keys[1], keys[2], keys[3]
+	poolA, poolB, userA, userB := keys[4], keys[5], keys[6], keys[7]
+	outer := solfi.NewSwapInstruction(1000, 1, 1, user, pair, poolA, poolB, userA, userB, solana.TokenProgramID, solana.SysVarInstructionsPubkey).Build()
+	transaction, meta := newTestInvokeTransaction(t, user, outer,
+		token.NewTransferInstruction(1000, userB, poolB, user, nil).Build(),
+		token.NewTransferInstruction(900, poolA, userA, pair, nil).Build(),
+	)
+	// the mints of the pool accounts are only known from the token balances
+	for i, key := range transaction.Message.AccountKeys {
+		mint := mintA
+		switch key {
+		case poolA:
+		case poolB:
+			mint = mintB
+		default:
+			continue
+		}
+		balance := rpc.TokenBalance{AccountIndex: uint16(i), Owner: &pair, ProgramId: &solana.TokenProgramID, Mint: mint, UiTokenAmount: &rpc.UiTokenAmount{Amount: "0", Decimals: 6}}
+		meta.PreTokenBalances = append(meta.PreTokenBalances, balance)
+		meta.PostTokenBalances = append(meta.PostTokenBalances, balance)
+	}
+
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	swap, ok := tx.Instructions[0].Event[0].(*types.Swap)
+	if !ok {
+		t.Fatalf("unexpected event %+v", tx.Instructions[0].Event[0])
+	}
+	if swap.InputTransfer == nil || swap.InputTransfer.Amount != 1000 || swap.InputTransfer.To != poolB {
+		t.Fatalf("unexpected input transfer %+v", swap.InputTransfer)
+	}
+	if swap.OutputTransfer == nil || swap.OutputTransfer.Amount != 900 || swap.OutputTransfer.From != poolA {
+		t.Fatalf("unexpected output transfer %+v", swap.OutputTransfer)
+	}
+	if len(tx.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics %+v", tx.Diagnostics)
+	}
+}
@@ -0,0 +1,133 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func newTestTransaction(t *testing.T, payer solana.PublicKey, instructions ...solana.Instruction) *solana.Transaction {
+	transaction, err := solana.NewTransaction(instructions, solana.Hash{}, solana.TransactionPayer(payer))
+	if err != nil {
+		t.Fatal(err)
+	}
+	transaction.Signatures = []solana.Signature{{1}}
+	return transaction
+}
+
+func TestTransaction_Meta_SolBalance(t *testing.T) {
+	from := solana.NewWallet().PublicKey()
+	to := solana.NewWallet().PublicKey()
+	transaction := newTestTransaction(t, from, system.NewTransferInstruction(1000, from, to).Build())
+	meta := &rpc.TransactionMeta{}
+	for _, key := range transaction.Message.AccountKeys {
+		switch key {
+		case from:
+			meta.PreBalances = append(meta.PreBalances, 10000)
+			meta.PostBalances = append(meta.PostBalances, 8995)
+		case to:
+			meta.PreBalances = append(meta.PreBalances, 0)
+			meta.PostBalances = append(meta.PostBalances, 1000)
+		default:
+			meta.PreBalances = append(meta.PreBalances, 1)
+			meta.PostBalances = append(meta.PostBalances, 1)
+		}
+	}
+
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil {
+		t.Fatal("invalid transaction")
+	}
+	if tx.Meta.SolPreBalance[from].IntPart() != 10000 || tx.Meta.SolPostBalance[from].IntPart() != 8995 {
+		t.Fatalf("unexpected sol balance of %s", from)
+	}
+	if tx.Meta.SolBalanceDelta[from].IntPart() != -1005 || tx.Meta.SolBalanceDelta[to].IntPart() != 1000 {
+		t.Fatal("unexpected sol balance delta")
+	}
+	if !tx.Meta.SolBalanceDelta[solana.SystemProgramID].IsZero() {
+		t.Fatal("unexpected sol balance delta of system program")
+	}
+}
+
+type testTokenTransfer struct {
+	transaction *solana.Transaction
+	meta        *rpc.TransactionMeta
+	mint        solana.PublicKey
+	owner       solana.PublicKey
+	receiver    solana.PublicKey
+	source      solana.PublicKey
+	destination solana.PublicKey
+}
+
+// newTestTokenTransfer builds a token transfer from owner to receiver, the token balances move 0.1 of a 6 decimals mint
+func newTestTokenTransfer(t *testing.T, amount uint64) *testTokenTransfer {
+	tt := &testTokenTransfer{
+		mint:        solana.NewWallet().PublicKey(),
+		owner:       solana.NewWallet().PublicKey(),
+		receiver:    solana.NewWallet().PublicKey(),
+		source:      solana.NewWallet().PublicKey(),
+		destination: solana.NewWallet().PublicKey(),
+	}
+	tt.transaction = newTestTransaction(t, tt.owner, token.NewTransferInstruction(amount, tt.source, tt.destination, tt.owner, nil).Build())
+	tt.meta = &rpc.TransactionMeta{}
+	for index, key := range tt.transaction.Message.AccountKeys {
+		tt.meta.PreBalances = append(tt.meta.PreBalances, 2039280)
+		tt.meta.PostBalances = append(tt.meta.PostBalances, 2039280)
+		var owner solana.PublicKey
+		var pre, post string
+		switch key {
+		case tt.source:
+			owner, pre, post = tt.owner, "1000000", "900000"
+		case tt.destination:
+			owner, pre, post = tt.receiver, "0", "100000"
+		default:
+			continue
+		}
+		tt.meta.PreTokenBalances = append(tt.meta.PreTokenBalances, rpc.TokenBalance{
+			AccountIndex:  uint16(index),
+			Owner:         &owner,
+			ProgramId:     &solana.TokenProgramID,
+			Mint:          tt.mint,
+			UiTokenAmount: &rpc.UiTokenAmount{Amount: pre, Decimals: 6},
+		})
+		tt.meta.PostTokenBalances = append(tt.meta.PostTokenBalances, rpc.TokenBalance{
+			AccountIndex:  uint16(index),
+			Owner:         &owner,
+			ProgramId:     &solana.TokenProgramID,
+			Mint:          tt.mint,
+			UiTokenAmount: &rpc.UiTokenAmount{Amount: post, Decimals: 6},
+		})
+	}
+	return tt
+}
+
+func TestTransaction_Meta_BalanceChanges(t *testing.T) {
+	tt := newTestTokenTransfer(t, 100000)
+	tx := ParseTransaction(0, tt.transaction, tt.meta)
+	if tx == nil {
+		t.Fatal("invalid transaction")
+	}
+	if len(tx.Meta.BalanceChanges) != 2 {
+		t.Fatalf("expected 2 balance changes, got %d", len(tx.Meta.BalanceChanges))
+	}
+	for _, change := range tx.Meta.BalanceChanges {
+		if change.Mint != tt.mint {
+			t.Fatalf("unexpected mint %s", change.Mint)
+		}
+		switch change.Owner {
+		case tt.owner:
+			if change.Change.String() != "-0.1" || change.Pre.String() != "1" {
+				t.Fatalf("unexpected change of owner %s", change.Change)
+			}
+		case tt.receiver:
+			if change.Change.String() != "0.1" {
+				t.Fatalf("unexpected change of receiver %s", change.Change)
+			}
+		default:
+			t.Fatalf("unexpected owner %s", change.Owner)
+		}
+	}
+}
@@ -0,0 +1,67 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransaction_ParserPanic(t *testing.T) {
+	faulty := solana.NewWallet().PublicKey()
+	program.RegisterParser(faulty, "Faulty", program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error {
+		var transfer *types.Transfer
+		in.Event = append(in.Event, &types.Transfer{Amount: transfer.Amount})
+		return nil
+	})
+	from := solana.NewWallet().PublicKey()
+	to := solana.NewWallet().PublicKey()
+	transaction := newTestTransaction(t, from,
+		solana.NewInstruction(faulty, solana.AccountMetaSlice{solana.Meta(from)}, []byte{1}),
+		system.NewTransferInstruction(1000, from, to).Build(),
+	)
+
+	tx := ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions) != 2 {
+		t.Fatal("invalid transaction")
+	}
+	if len(tx.Diagnostics) != 1 {
+		t.Fatalf("expected 1 diagnostic, got %d", len(tx.Diagnostics))
+	}
+	diagnostic := tx.Diagnostics[0]
+	if diagnostic.Kind != types.DiagnosticPanic || diagnostic.Program != faulty || diagnostic.Signature != transaction.Signatures[0] {
+		t.Fatalf("unexpected diagnostic %+v", diagnostic)
+	}
+	if len(diagnostic.Path) != 1 || diagnostic.Path[0] != 1 {
+		t.Fatalf("unexpected diagnostic path %v", diagnostic.Path)
+	}
+	if len(tx.Instructions[1].Event) != 1 {
+		t.Fatal("instructions after the panic are not parsed")
+	}
+}
+
+func TestBlock_TransactionPanic(t *testing.T) {
+	b := newTestBlock(t, 3)
+	b.BlockTime = nil
+	meta := *b.Transactions[1].Meta
+	meta.InnerInstructions = []rpc.InnerInstruction{{
+		Index:        0,
+		Instructions: []solana.CompiledInstruction{{ProgramIDIndex: 255}},
+	}}
+	b.Transactions[1].Meta = &meta
+
+	for _, block := range []*types.Block{ParseBlock(1, b), ParseBlockConcurrent(1, b, 2)} {
+		if len(block.Transaction) != 2 {
+			t.Fatalf("expected 2 transactions, got %d", len(block.Transaction))
+		}
+		if len(block.Diagnostics) != 1 || block.Diagnostics[0].Kind != types.DiagnosticPanic {
+			t.Fatal("expected a panic diagnostic")
+		}
+		if block.Diagnostics[0].Signature != (solana.Signature{1, 0, 1}) {
+			t.Fatalf("unexpected signature %s", block.Diagnostics[0].Signature)
+		}
+	}
+}
@@ -0,0 +1,77 @@
+package solanaparser
+
+import (
+	"encoding/json"
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/system"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransaction_Path(t *testing.T) {
+	nt := newTestNestedTransfer(t, 100000, 2)
+	tx := ParseTransaction(0, nt.transaction, nt.meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Children) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	outer := tx.Instructions[0]
+	child := outer.Children[0]
+	if outer.PathString() != "1" || outer.Depth != 1 || outer.Parent != nil {
+		t.Fatalf("unexpected outer path %s depth %d", outer.PathString(), outer.Depth)
+	}
+	if child.PathString() != "1.1" || child.Depth != 2 || child.Parent != outer || child.Root() != outer {
+		t.Fatalf("unexpected child path %s depth %d", child.PathString(), child.Depth)
+	}
+	if child.FindParentByProgram(nt.program) != outer || child.FindParentByProgram(child.RawInstruction.ProgID) != nil {
+		t.Fatal("unexpected parent by program")
+	}
+	if id := child.EventID(tx.Hash, 0); id != tx.Hash.String()+":1.1:0" {
+		t.Fatalf("unexpected event id %s", id)
+	}
+
+	data, err := json.Marshal(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var decoded types.Transaction
+	if err := json.Unmarshal(data, &decoded); err != nil {
+		t.Fatal(err)
+	}
+	child = decoded.Instructions[0].Children[0]
+	if child.PathString() != "1.1" || child.Depth != 2 || child.Parent != decoded.Instructions[0] {
+		t.Fatal("path & parent are not decoded")
+	}
+}
+
+func TestTransaction_PathInnerInstructionIndex(t *testing.T) {
+	payer := solana.NewWallet().PublicKey()
+	first := solana.NewWallet().PublicKey()
+	second := solana.NewWallet().PublicKey()
+	transaction := newTestTransaction(t, payer,
+		system.NewTransferInstruction(1, payer, first).Build(),
+		system.NewTransferInstruction(2, payer, second).Build(),
+	)
+	inner := transaction.Message.Instructions[1]
+	inner.StackHeight = 2
+	meta := &rpc.TransactionMeta{
+		PreBalances:  make([]uint64, len(transaction.Message.AccountKeys)),
+		PostBalances: make([]uint64, len(transaction.Message.AccountKeys)),
+		InnerInstructions: []rpc.InnerInstruction{
+			{Index: 1, Instructions: []solana.CompiledInstruction{inner}},
+			// an index without top level instruction is dropped
+			{Index: 5, Instructions: []solana.CompiledInstruction{inner}},
+		},
+	}
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 2 {
+		t.Fatal("invalid transaction")
+	}
+	if len(tx.Instructions[0].Children) != 0 || len(tx.Instructions[1].Children) != 1 {
+		t.Fatal("inner instructions are attached to the wrong parent")
+	}
+	if child := tx.Instructions[1].Children[0]; child.PathString() != "2.1" || child.Parent != tx.Instructions[1] {
+		t.Fatalf("unexpected child path %s", child.PathString())
+	}
+}
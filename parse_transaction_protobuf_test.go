@@ -0,0 +1,187 @@
+package solanaparser
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/pb"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+	"google.golang.org/protobuf/encoding/protojson"
+	"google.golang.org/protobuf/proto"
+)
+
+type testOtherEvent struct {
+	ProgramId solana.PublicKey
+	Value     int
+}
+
+func (e *testOtherEvent) Kind() string              { return "test_other_event" }
+func (e *testOtherEvent) Program() solana.PublicKey { return e.ProgramId }
+
+// normalizeJSON decodes json into generic values & drops the empty values, nil & empty slices encode the same in protobuf
+func normalizeJSON(t *testing.T, v interface{}) interface{} {
+	data, err := json.Marshal(v)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var value interface{}
+	if err := json.Unmarshal(data, &value); err != nil {
+		t.Fatal(err)
+	}
+	var normalize func(v interface{}) interface{}
+	normalize = func(v interface{}) interface{} {
+		switch v := v.(type) {
+		case map[string]interface{}:
+			for key, item := range v {
+				item = normalize(item)
+				if item == nil {
+					delete(v, key)
+				} else {
+					v[key] = item
+				}
+			}
+			if len(v) == 0 {
+				return nil
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				return nil
+			}
+			for i, item := range v {
+				v[i] = normalize(item)
+			}
+		}
+		return v
+	}
+	return normalize(value)
+}
+
+func TestTransaction_Protobuf(t *testing.T) {
+	types.RegisterEvent(func() types.Event { return &testOtherEvent{} })
+	tt := newTestTokenTransfer(t, 100000)
+	tx := ParseTransaction(0, tt.transaction, tt.meta)
+	if tx == nil || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	transfer := tx.Instructions[0].Event[0].(*types.Transfer)
+	swap := &types.Swap{
+		Dex:            solana.NewWallet().PublicKey(),
+		Pool:           solana.NewWallet().PublicKey(),
+		User:           tt.owner,
+		InputTransfer:  transfer,
+		OutputTransfer: &types.Transfer{Mint: solana.SolMint, Amount: 5},
+		SwapAmounts: types.SwapAmounts{
+			MintIn:    tt.mint,
+			MintOut:   solana.SolMint,
+			AmountIn:  100000,
+			AmountOut: 5,
+			Price:     decimal.RequireFromString("0.00005"),
+			Source:    types.AmountSourceTransfer,
+		},
+	}
+	tx.Instructions[0].Receipt = []types.Event{
+		swap,
+		&types.Route{Router: solana.NewWallet().PublicKey(), RouteSteps: []*types.RouteStep{
+			{Dex: swap.Dex, Swap: swap, RoutePlan: &types.RoutePlan{}},
+			{SwapEvent: &types.SwapEvent{InputAmount: 1, InputDecimals: 6, InputUiAmount: decimal.RequireFromString("0.000001")}},
+		}},
+		&types.DlmmSwapEvent{StartBinId: -3, EndBinId: 2, FeeBps: decimal.RequireFromString("12.5")},
+		&types.ClmmSwapEvent{Tick: -443636, SqrtPriceX64: decimal.RequireFromString("79226673521066979257578248091"), ZeroForOne: true},
+		&types.MemeBuy{Mint: tt.mint, SolTransfer: &types.Transfer{Amount: 7}},
+		&types.UnknownInstruction{ProgramName: "unknown", DataLength: 9, Accounts: []solana.PublicKey{{}, tt.owner}},
+		&types.DecodedInstruction{Name: "swap", Args: map[string]interface{}{"amount": "1", "nested": map[string]interface{}{"ok": true}}, Accounts: map[string]solana.PublicKey{"pool.state": swap.Pool}},
+		&testOtherEvent{ProgramId: tt.owner, Value: 42},
+	}
+	tx.Diagnostics = append(tx.Diagnostics, &types.Diagnostic{Signature: tx.Hash, Path: []int{1, 2}, Kind: types.DiagnosticPanic, Message: "panic"})
+	tx.Instructions[0].Logs = []string{"Program log: a", ""}
+
+	data, err := pb.MarshalTransaction(tx)
+	if err != nil {
+		t.Fatal(err)
+	}
+	again, err := pb.MarshalTransaction(tx)
+	if err != nil || string(again) != string(data) {
+		t.Fatal("encoding is not deterministic")
+	}
+	decoded, err := pb.UnmarshalTransaction(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, got := normalizeJSON(t, tx), normalizeJSON(t, decoded); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("unexpected decoded transaction\nexpected %v\ngot      %v", expected, got)
+	}
+	if clmm := decoded.Instructions[0].Receipt[3].(*types.ClmmSwapEvent); clmm.Tick != -443636 {
+		t.Fatalf("unexpected tick %d", clmm.Tick)
+	}
+	if other, ok := decoded.Instructions[0].Receipt[7].(*testOtherEvent); !ok || other.Value != 42 {
+		t.Fatalf("unexpected other event %+v", decoded.Instructions[0].Receipt[7])
+	}
+	if len(decoded.Instructions[0].Logs) != 2 {
+		t.Fatal("empty log line is dropped")
+	}
+
+	// the bytes are read by the protobuf runtime as any consumer would
+	message := &pb.Transaction{}
+	if err := proto.Unmarshal(data, message); err != nil {
+		t.Fatal(err)
+	}
+	if string(message.GetHash()) != string(tx.Hash[:]) || message.GetSlot() != tx.Slot {
+		t.Fatal("unexpected hash or slot")
+	}
+	receipts := message.GetInstructions()[0].GetReceipts()
+	if len(receipts) != 8 {
+		t.Fatalf("unexpected receipts %d", len(receipts))
+	}
+	if got := receipts[0].GetSwap().GetInputTransfer(); got.GetAmount() != 100000 || string(got.GetMint()) != string(tt.mint[:]) {
+		t.Fatalf("unexpected input transfer %v", got)
+	}
+	if got := receipts[0].GetSwap().GetAmounts(); got.GetPrice() != "0.00005" || got.GetSource() != types.AmountSourceTransfer {
+		t.Fatalf("unexpected swap amounts %v", got)
+	}
+	if got := receipts[3].GetClmmSwapEvent().GetTick(); got != -443636 {
+		t.Fatalf("unexpected tick %d", got)
+	}
+	if got := receipts[7].GetOther(); got.GetKind() != "test_other_event" {
+		t.Fatalf("unexpected other event %v", got)
+	}
+	if _, err := protojson.Marshal(message); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := pb.UnmarshalTransaction(data[:len(data)-1]); !errors.Is(err, pb.ErrInvalidMessage) {
+		t.Fatalf("truncated message should fail, got %v", err)
+	}
+	message.GetInstructions()[0].ProgramId = []byte{1, 2, 3}
+	if _, err := pb.ToTransaction(message); !errors.Is(err, pb.ErrInvalidMessage) {
+		t.Fatalf("short program id should fail, got %v", err)
+	}
+}
+
+func TestBlock_Protobuf(t *testing.T) {
+	block := ParseBlock(1, newTestBlock(t, 3), WithReconcile())
+	data, err := pb.MarshalBlock(block)
+	if err != nil {
+		t.Fatal(err)
+	}
+	decoded, err := pb.UnmarshalBlock(data)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if expected, got := normalizeJSON(t, block), normalizeJSON(t, decoded); !reflect.DeepEqual(expected, got) {
+		t.Fatalf("unexpected decoded block\nexpected %v\ngot      %v", expected, got)
+	}
+	for _, tx := range decoded.Transaction {
+		tx.Walk(func(in *types.Instruction) bool {
+			for _, child := range in.Children {
+				if child.Parent != in {
+					t.Fatal("children are not linked to their parent")
+				}
+			}
+			return true
+		})
+	}
+}
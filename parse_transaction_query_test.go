@@ -0,0 +1,57 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/token"
+)
+
+func TestTransaction_Query(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	router := solana.NewWallet().PublicKey()
+	mint := solana.NewWallet().PublicKey()
+	source := solana.NewWallet().PublicKey()
+	vault := solana.NewWallet().PublicKey()
+	transaction, meta := newTestInnerTransaction(t, owner, router,
+		token.NewTransferCheckedInstruction(100, 6, source, mint, vault, owner, nil).Build(),
+		token.NewTransferCheckedInstruction(200, 6, source, mint, vault, owner, nil).Build(),
+		token.NewTransferCheckedInstruction(300, 6, vault, mint, source, owner, nil).Build(),
+	)
+	tx := ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Children) != 3 {
+		t.Fatal("invalid transaction")
+	}
+	in := tx.Instructions[0]
+
+	if transfers := types.FindEvents[*types.Transfer](tx); len(transfers) != 3 || transfers[2].Amount != 300 {
+		t.Fatalf("expected 3 transfers in order, got %d", len(transfers))
+	}
+	if unknowns := types.FindEvents[*types.UnknownInstruction](in); len(unknowns) != 1 || unknowns[0].ProgramId != router {
+		t.Fatal("router should be an unknown instruction")
+	}
+	if transfers := tx.FindAllTransfersTo(vault); len(transfers) != 2 || transfers[0].Amount != 100 || transfers[1].Amount != 200 {
+		t.Fatal("unexpected transfers to the vault")
+	}
+	if transfers := in.FindAllTransfersFrom(vault); len(transfers) != 1 || transfers[0].Amount != 300 {
+		t.Fatal("unexpected transfers from the vault")
+	}
+	if found := tx.FindByProgram(solana.TokenProgramID, false); len(found) != 0 {
+		t.Fatal("token instructions are not top level")
+	}
+	if found := tx.FindByProgram(solana.TokenProgramID, true); len(found) != 3 {
+		t.Fatalf("expected 3 token instructions, got %d", len(found))
+	}
+	if found := in.FindByProgram(solana.TokenProgramID, false); len(found) != 3 {
+		t.Fatalf("expected 3 token children, got %d", len(found))
+	}
+	visited := 0
+	tx.Walk(func(in *types.Instruction) bool {
+		visited++
+		return false
+	})
+	if visited != 1 {
+		t.Fatal("children should be skipped")
+	}
+}
@@ -0,0 +1,42 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/types"
+)
+
+func TestTransaction_Reconcile_Match(t *testing.T) {
+	tt := newTestTokenTransfer(t, 100000)
+	tx := ParseTransaction(0, tt.transaction, tt.meta, WithReconcile())
+	if tx == nil {
+		t.Fatal("invalid transaction")
+	}
+	if len(tx.Diagnostics) != 0 {
+		t.Fatalf("unexpected diagnostics %+v", tx.Diagnostics[0])
+	}
+}
+
+func TestTransaction_Reconcile_Mismatch(t *testing.T) {
+	tt := newTestTokenTransfer(t, 50000)
+	tx := ParseTransaction(0, tt.transaction, tt.meta, WithReconcile())
+	if tx == nil {
+		t.Fatal("invalid transaction")
+	}
+	if len(tx.Diagnostics) != 2 {
+		t.Fatalf("expected 2 diagnostics, got %d", len(tx.Diagnostics))
+	}
+	for _, diagnostic := range tx.Diagnostics {
+		if diagnostic.Kind != types.DiagnosticBalanceMismatch {
+			t.Fatalf("unexpected diagnostic kind %s", diagnostic.Kind)
+		}
+		if diagnostic.Account != tt.source && diagnostic.Account != tt.destination {
+			t.Fatalf("unexpected diagnostic account %s", diagnostic.Account)
+		}
+	}
+
+	tx = ParseTransaction(0, tt.transaction, tt.meta)
+	if len(tx.Diagnostics) != 0 {
+		t.Fatal("reconcile is not enabled")
+	}
+}
@@ -0,0 +1,40 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+)
+
+func TestTransaction_Actions(t *testing.T) {
+	nt := newTestNestedTransfer(t, 100000, 2)
+	registry := program.DefaultRegistry.Subset("token")
+	parser := NewParser(registry)
+
+	tx := parser.ParseTransaction(0, nt.transaction, nt.meta)
+	if tx == nil || len(tx.Actions) != 1 {
+		t.Fatal("expected 1 action")
+	}
+	if _, ok := tx.Actions[0].Action.(*types.Transfer); !ok || tx.Actions[0].Name != "token" {
+		t.Fatalf("unexpected action %+v", tx.Actions[0])
+	}
+	if len(tx.Actions[0].Path) != 2 || tx.Actions[0].Path[1] != 1 {
+		t.Fatalf("unexpected action path %v", tx.Actions[0].Path)
+	}
+
+	registry.Register(nt.program, "Dex", program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error {
+		in.Event = append(in.Event, &types.Swap{Dex: nt.program})
+		return nil
+	})
+	tx = parser.ParseTransaction(0, nt.transaction, nt.meta)
+	if tx == nil || len(tx.Actions) != 1 {
+		t.Fatal("expected 1 action")
+	}
+	if _, ok := tx.Actions[0].Action.(*types.Swap); !ok || tx.Actions[0].Type != program.Swap || tx.Actions[0].Priority != 1 {
+		t.Fatalf("unexpected action %+v", tx.Actions[0])
+	}
+	if len(tx.Actions[0].Path) != 1 {
+		t.Fatalf("unexpected action path %v", tx.Actions[0].Path)
+	}
+}
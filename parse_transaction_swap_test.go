@@ -0,0 +1,187 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/meteora_dlmm"
+	"github.com/gagliardetto/solana-go/programs/raydium_cp"
+	"github.com/gagliardetto/solana-go/programs/token"
+	"github.com/gagliardetto/solana-go/rpc"
+	"github.com/shopspring/decimal"
+)
+
+// newTestInnerTransaction builds an instruction of the program which invokes the inner instructions
+func newTestInnerTransaction(t *testing.T, payer solana.PublicKey, programId solana.PublicKey, inner ...solana.Instruction) (*solana.Transaction, *rpc.TransactionMeta) {
+	accounts := solana.AccountMetaSlice{}
+	for _, instruction := range inner {
+		accounts = append(accounts, instruction.Accounts()...)
+		accounts = append(accounts, solana.Meta(instruction.ProgramID()))
+	}
+	return newTestInvokeTransaction(t, payer, solana.NewInstruction(programId, accounts, []byte{1}), inner...)
+}
+
+// newTestInvokeTransaction builds the outer instruction which invokes the inner instructions, the accounts of the inner instructions must be in the transaction
+func newTestInvokeTransaction(t *testing.T, payer solana.PublicKey, outer solana.Instruction, inner ...solana.Instruction) (*solana.Transaction, *rpc.TransactionMeta) {
+	transaction := newTestTransaction(t, payer, outer)
+	index := func(key solana.PublicKey) uint16 {
+		for i, k := range transaction.Message.AccountKeys {
+			if k == key {
+				return uint16(i)
+			}
+		}
+		t.Fatalf("account %s not found", key)
+		return 0
+	}
+	compiled := make([]solana.CompiledInstruction, 0, len(inner))
+	for _, instruction := range inner {
+		data, err := instruction.Data()
+		if err != nil {
+			t.Fatal(err)
+		}
+		indexes := make([]uint16, 0, len(instruction.Accounts()))
+		for _, account := range instruction.Accounts() {
+			indexes = append(indexes, index(account.PublicKey))
+		}
+		compiled = append(compiled, solana.CompiledInstruction{
+			ProgramIDIndex: index(instruction.ProgramID()),
+			Accounts:       indexes,
+			Data:           data,
+			StackHeight:    2,
+		})
+	}
+	meta := &rpc.TransactionMeta{
+		PreBalances:       make([]uint64, len(transaction.Message.AccountKeys)),
+		PostBalances:      make([]uint64, len(transaction.Message.AccountKeys)),
+		InnerInstructions: []rpc.InnerInstruction{{Index: 0, Instructions: compiled}},
+	}
+	return transaction, meta
+}
+
+func TestTransaction_SwapAmounts(t *testing.T) {
+	dex := solana.NewWallet().PublicKey()
+	user := solana.NewWallet().PublicKey()
+	mintA := solana.NewWallet().PublicKey()
+	mintB := solana.NewWallet().PublicKey()
+	userA := solana.NewWallet().PublicKey()
+	userB := solana.NewWallet().PublicKey()
+	vaultA := solana.NewWallet().PublicKey()
+	vaultB := solana.NewWallet().PublicKey()
+	registry := program.DefaultRegistry.Subset("token")
+	registry.Register(dex, "TestDex", program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error {
+		swap := &types.Swap{
+			Dex:  in.RawInstruction.ProgID,
+			User: user,
+		}
+		swap.InputTransfer = in.FindChildTransferByTo(vaultA)
+		swap.OutputTransfer = in.FindChildTransferByFrom(vaultB)
+		in.Event = []types.Event{swap}
+		return nil
+	})
+	transaction, meta := newTestInnerTransaction(t, user, dex,
+		token.NewTransferCheckedInstruction(2000000, 6, userA, mintA, vaultA, user, nil).Build(),
+		token.NewTransferCheckedInstruction(5000000000, 9, vaultB, mintB, userB, vaultB, nil).Build(),
+	)
+
+	tx := NewParser(registry).ParseTransaction(0, transaction, meta)
+	if tx == nil || len(tx.Instructions) != 1 || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("invalid transaction")
+	}
+	swap := tx.Instructions[0].Event[0].(*types.Swap)
+	if swap.Source != types.AmountSourceTransfer || swap.MintIn != mintA || swap.MintOut != mintB {
+		t.Fatalf("unexpected swap amounts %+v", swap.SwapAmounts)
+	}
+	if swap.AmountIn != 2000000 || swap.AmountOut != 5000000000 || !swap.Price.Equal(decimal.RequireFromString("2.5")) {
+		t.Fatalf("unexpected swap amounts %+v", swap.SwapAmounts)
+	}
+}
+
+func TestTransaction_SwapAmountsFromEvents(t *testing.T) {
+	mint := solana.NewWallet().PublicKey()
+	wsol := solana.SolMint
+	sell := &types.MemeSell{Mint: mint}
+	swap := &types.Swap{}
+	route := &types.Route{
+		RouteSteps: []*types.RouteStep{{
+			Swap:      swap,
+			SwapEvent: &types.SwapEvent{InputMint: wsol, InputAmount: 1000000000, OutputMint: mint, OutputAmount: 3000000},
+		}},
+	}
+	tx := &types.Transaction{
+		Meta: &types.Meta{
+			MintAccounts: map[solana.PublicKey]*types.MintAccount{
+				{}:   {Decimals: 9},
+				wsol: {Mint: wsol, Decimals: 9},
+				mint: {Mint: mint, Decimals: 6},
+			},
+		},
+		Instructions: []*types.Instruction{
+			{
+				Event:   []types.Event{sell},
+				Receipt: []types.Event{&types.MemeSellEvent{Mint: mint, TokenAmount: 4000000, SolAmount: 2000000000}},
+			},
+			{
+				Event:    []types.Event{route},
+				Children: []*types.Instruction{{Event: []types.Event{swap}}},
+			},
+		},
+	}
+	fillSwapAmounts(tx)
+	if sell.Source != types.AmountSourceEvent || sell.MintIn != mint || sell.MintOut != (solana.PublicKey{}) {
+		t.Fatalf("unexpected meme sell amounts %+v", sell.SwapAmounts)
+	}
+	if !sell.Price.Equal(decimal.RequireFromString("0.5")) {
+		t.Fatalf("unexpected meme sell price %s", sell.Price)
+	}
+	if swap.Source != types.AmountSourceEvent || swap.AmountOut != 3000000 || !swap.Price.Equal(decimal.RequireFromString("3")) {
+		t.Fatalf("unexpected route swap amounts %+v", swap.SwapAmounts)
+	}
+}
+
+func TestTransaction_SwapAmountsFromProgramEvents(t *testing.T) {
+	mint := solana.NewWallet().PublicKey()
+	wsol := solana.SolMint
+	account0 := solana.NewWallet().PublicKey()
+	account1 := solana.NewWallet().PublicKey()
+	clmm, cp, dlmm := &types.Swap{}, &types.Swap{}, &types.Swap{}
+	tx := &types.Transaction{
+		Meta: &types.Meta{
+			TokenAccounts: map[solana.PublicKey]*types.TokenAccount{
+				account0: {Mint: wsol},
+				account1: {Mint: mint},
+			},
+			MintAccounts: map[solana.PublicKey]*types.MintAccount{
+				wsol: {Mint: wsol, Decimals: 9},
+				mint: {Mint: mint, Decimals: 6},
+			},
+		},
+		Instructions: []*types.Instruction{
+			{
+				Event:   []types.Event{clmm},
+				Receipt: []types.Event{&types.ClmmSwapEvent{TokenAccount0: account0, TokenAccount1: account1, Amount0: 3000000000, Amount1: 1000000, ZeroForOne: false}},
+			},
+			{
+				Event:             []types.Event{cp},
+				ParsedInstruction: raydium_cp.NewSwapBaseInputInstructionBuilder().SetInputTokenMintAccount(wsol).SetOutputTokenMintAccount(mint),
+				Receipt:           []types.Event{&types.CpSwapEvent{InputAmount: 1000000000, OutputAmount: 3000000}},
+			},
+			{
+				Event:             []types.Event{dlmm},
+				ParsedInstruction: meteora_dlmm.NewSwapInstructionBuilder().SetTokenXMintAccount(wsol).SetTokenYMintAccount(mint),
+				Receipt:           []types.Event{&types.DlmmSwapEvent{AmountIn: 3000000, AmountOut: 1000000000, SwapForY: false}},
+			},
+		},
+	}
+	fillSwapAmounts(tx)
+	if clmm.Source != types.AmountSourceEvent || clmm.MintIn != mint || clmm.AmountIn != 1000000 || clmm.MintOut != wsol || clmm.AmountOut != 3000000000 {
+		t.Fatalf("unexpected clmm swap amounts %+v", clmm.SwapAmounts)
+	}
+	if cp.Source != types.AmountSourceEvent || cp.MintIn != wsol || cp.MintOut != mint || !cp.Price.Equal(decimal.RequireFromString("3")) {
+		t.Fatalf("unexpected cp swap amounts %+v", cp.SwapAmounts)
+	}
+	if dlmm.Source != types.AmountSourceEvent || dlmm.MintIn != mint || dlmm.AmountIn != 3000000 || dlmm.MintOut != wsol || dlmm.AmountOut != 1000000000 {
+		t.Fatalf("unexpected dlmm swap amounts %+v", dlmm.SwapAmounts)
+	}
+}
@@ -0,0 +1,93 @@
+package solanaparser
+
+import (
+	"testing"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/jupiter"
+	"github.com/gagliardetto/solana-go/programs/raydium_cp"
+	"github.com/gagliardetto/solana-go/rpc"
+)
+
+func TestTransaction_UnknownInstruction(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	unknown := solana.NewWallet().PublicKey()
+	known := solana.NewWallet().PublicKey()
+	registry := program.NewRegistry()
+	registry.Register(known, "Known", program.Swap, 1, func(in *types.Instruction, meta *types.Meta) error {
+		return program.ErrParserNotFound
+	})
+	transaction := newTestTransaction(t, owner,
+		solana.NewInstruction(unknown, solana.AccountMetaSlice{solana.Meta(owner).SIGNER().WRITE()}, []byte{1, 2, 3, 4, 5, 6, 7, 8, 9, 10}),
+		solana.NewInstruction(known, solana.AccountMetaSlice{solana.Meta(owner)}, []byte{5}),
+	)
+	tx := NewParser(registry).ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions) != 2 {
+		t.Fatal("invalid transaction")
+	}
+
+	event, ok := tx.Instructions[0].Event[0].(*types.UnknownInstruction)
+	if !ok || event.ProgramId != unknown || event.ProgramName != "" {
+		t.Fatalf("unexpected event %+v", tx.Instructions[0].Event[0])
+	}
+	if event.Discriminator1 != "01" || event.Discriminator4 != "01020304" || event.Discriminator8 != "0102030405060708" || event.DataLength != 10 {
+		t.Fatalf("unexpected discriminators %+v", event)
+	}
+	if len(event.Accounts) != 1 || event.Accounts[0] != owner {
+		t.Fatalf("unexpected accounts %v", event.Accounts)
+	}
+
+	// an unknown discriminator of a known program
+	if len(tx.Instructions[1].Event) != 1 {
+		t.Fatal("expected an unknown instruction event")
+	}
+	event, ok = tx.Instructions[1].Event[0].(*types.UnknownInstruction)
+	if !ok || event.ProgramName != "Known" || event.Discriminator8 != "05" {
+		t.Fatalf("unexpected event %+v", tx.Instructions[1].Event[0])
+	}
+	if len(tx.Actions) != 0 {
+		t.Fatal("unknown instructions should not be primary actions")
+	}
+	if len(tx.Diagnostics) != 1 || tx.Diagnostics[0].Kind != types.DiagnosticParserNotFound {
+		t.Fatal("expected a parser not found diagnostic")
+	}
+}
+
+func TestTransaction_UnknownInstructionJupiter(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	sharedAccountsRoute := jupiter.Instruction_SharedAccountsRoute
+	transaction := newTestTransaction(t, owner,
+		solana.NewInstruction(jupiter.ProgramID, solana.AccountMetaSlice{solana.Meta(owner).SIGNER()}, append(sharedAccountsRoute[:], 1)),
+	)
+	tx := ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("expected an unknown instruction event")
+	}
+	event, ok := tx.Instructions[0].Event[0].(*types.UnknownInstruction)
+	if !ok || event.ProgramName != jupiter.ProgramName || event.Discriminator8 != "c1209b3341d69c81" {
+		t.Fatalf("unexpected event %+v", tx.Instructions[0].Event[0])
+	}
+	if len(tx.Diagnostics) != 1 || tx.Diagnostics[0].Kind != types.DiagnosticParserNotFound {
+		t.Fatal("expected a parser not found diagnostic")
+	}
+}
+
+func TestTransaction_UnknownInstructionDiscriminator(t *testing.T) {
+	owner := solana.NewWallet().PublicKey()
+	transaction := newTestTransaction(t, owner,
+		solana.NewInstruction(raydium_cp.ProgramID, solana.AccountMetaSlice{solana.Meta(owner).SIGNER()}, []byte{0xff, 0xfe, 0xfd, 0xfc, 0xfb, 0xfa, 0xf9, 0xf8, 1}),
+	)
+	tx := ParseTransaction(0, transaction, &rpc.TransactionMeta{})
+	if tx == nil || len(tx.Instructions[0].Event) != 1 {
+		t.Fatal("expected an unknown instruction event")
+	}
+	event, ok := tx.Instructions[0].Event[0].(*types.UnknownInstruction)
+	if !ok || event.ProgramId != raydium_cp.ProgramID || event.Discriminator8 != "fffefdfcfbfaf9f8" {
+		t.Fatalf("unexpected event %+v", tx.Instructions[0].Event[0])
+	}
+	if len(tx.Diagnostics) != 1 || tx.Diagnostics[0].Kind != types.DiagnosticParserNotFound {
+		t.Fatalf("expected a parser not found diagnostic, got %+v", tx.Diagnostics)
+	}
+}
@@ -0,0 +1,569 @@
+package pb
+
+import (
+	"bytes"
+	"encoding/json"
+	"sort"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
+
+// FromBlock converts a block to its message
+func FromBlock(block *types.Block) (*Block, error) {
+	c := &fromConverter{}
+	m := &Block{
+		Hash: trimZero(block.Hash[:]),
+		Time: block.Time,
+		Slot: block.Slot,
+	}
+	for _, t := range block.Transaction {
+		m.Transactions = append(m.Transactions, c.transaction(t))
+	}
+	for _, diagnostic := range block.Diagnostics {
+		m.Diagnostics = append(m.Diagnostics, fromDiagnostic(diagnostic))
+	}
+	return m, c.err
+}
+
+// FromTransaction converts a transaction to its message
+func FromTransaction(t *types.Transaction) (*Transaction, error) {
+	c := &fromConverter{}
+	m := c.transaction(t)
+	return m, c.err
+}
+
+// FromEvent converts an event to its message, events of other packages are converted to json
+func FromEvent(event types.Event) (*Event, error) {
+	c := &fromConverter{}
+	m := c.event(event)
+	return m, c.err
+}
+
+// fromConverter keeps the first error of a conversion, only the json of the values of other packages may fail
+type fromConverter struct {
+	err error
+}
+
+func (c *fromConverter) fail(err error) {
+	if c.err == nil {
+		c.err = err
+	}
+}
+
+func (c *fromConverter) json(v interface{}) []byte {
+	if v == nil {
+		return nil
+	}
+	data, err := json.Marshal(v)
+	if err != nil {
+		c.fail(err)
+	}
+	return data
+}
+
+func (c *fromConverter) transaction(t *types.Transaction) *Transaction {
+	m := &Transaction{
+		Hash:   trimZero(t.Hash[:]),
+		Time:   t.Time,
+		Slot:   t.Slot,
+		Seq:    int64(t.Seq),
+		Failed: t.Failed,
+	}
+	for _, in := range t.Instructions {
+		m.Instructions = append(m.Instructions, c.instruction(in))
+	}
+	if t.Meta != nil {
+		m.Meta = fromMeta(t.Meta)
+	}
+	for _, diagnostic := range t.Diagnostics {
+		m.Diagnostics = append(m.Diagnostics, fromDiagnostic(diagnostic))
+	}
+	for _, action := range t.Actions {
+		a := &PrimaryAction{
+			Path:     fromPath(action.Path),
+			Program:  fromKey(action.Program),
+			Name:     action.Name,
+			Type:     action.Type,
+			Priority: int64(action.Priority),
+		}
+		if action.Action != nil {
+			a.Action = c.event(action.Action)
+		}
+		m.Actions = append(m.Actions, a)
+	}
+	return m
+}
+
+func (c *fromConverter) instruction(in *types.Instruction) *Instruction {
+	m := &Instruction{
+		Seq:          int64(in.Seq),
+		Path:         fromPath(in.Path),
+		Depth:        int64(in.Depth),
+		Logs:         in.Logs,
+		ComputeUnits: in.ComputeUnits,
+		Success:      in.Success,
+		Failed:       in.Failed,
+		Error:        in.Error,
+	}
+	if in.RawInstruction != nil {
+		m.ProgramId = fromKey(in.RawInstruction.ProgID)
+		m.Accounts = fromAccountMetas(in.RawInstruction.AccountValues)
+		m.Data = in.RawInstruction.DataBytes
+	}
+	m.ParsedInstruction = c.json(in.ParsedInstruction)
+	for _, event := range in.Event {
+		m.Events = append(m.Events, c.event(event))
+	}
+	for _, event := range in.Receipt {
+		m.Receipts = append(m.Receipts, c.event(event))
+	}
+	for _, child := range in.Children {
+		m.Children = append(m.Children, c.instruction(child))
+	}
+	return m
+}
+
+func fromAccountMetas(accounts []*solana.AccountMeta) []*AccountMeta {
+	var result []*AccountMeta
+	for _, account := range accounts {
+		result = append(result, &AccountMeta{
+			PublicKey:  fromKey(account.PublicKey),
+			IsWritable: account.IsWritable,
+			IsSigner:   account.IsSigner,
+		})
+	}
+	return result
+}
+
+// fromMeta lists the maps of the meta in key order, so that the encoding is deterministic
+func fromMeta(meta *types.Meta) *Meta {
+	m := &Meta{
+		Accounts:         fromAccountMetas(meta.Accounts),
+		TokenPreBalance:  fromBalances(meta.TokenPreBalance),
+		TokenPostBalance: fromBalances(meta.TokenPostBalance),
+		SolPreBalance:    fromBalances(meta.SolPreBalance),
+		SolPostBalance:   fromBalances(meta.SolPostBalance),
+		SolBalanceDelta:  fromBalances(meta.SolBalanceDelta),
+		ErrorMessage:     meta.ErrorMessage,
+	}
+	for _, key := range sortedKeys(meta.TokenAccounts) {
+		account := meta.TokenAccounts[key]
+		tokenAccount := &TokenAccount{
+			Account: fromKey(key),
+			Mint:    fromKey(account.Mint),
+		}
+		if account.Owner != nil {
+			tokenAccount.Owner = account.Owner.Bytes()
+		}
+		if account.ProgramId != nil {
+			tokenAccount.ProgramId = account.ProgramId.Bytes()
+		}
+		m.TokenAccounts = append(m.TokenAccounts, tokenAccount)
+	}
+	for _, key := range sortedKeys(meta.MintAccounts) {
+		account := meta.MintAccounts[key]
+		m.MintAccounts = append(m.MintAccounts, &MintAccount{
+			Mint:     fromKey(account.Mint),
+			Decimals: uint32(account.Decimals),
+		})
+	}
+	for _, change := range meta.BalanceChanges {
+		m.BalanceChanges = append(m.BalanceChanges, &BalanceChange{
+			Owner:  fromKey(change.Owner),
+			Mint:   fromKey(change.Mint),
+			Pre:    fromDecimal(change.Pre),
+			Post:   fromDecimal(change.Post),
+			Change: fromDecimal(change.Change),
+		})
+	}
+	return m
+}
+
+func fromBalances(balances map[solana.PublicKey]decimal.Decimal) []*Balance {
+	var result []*Balance
+	for _, key := range sortedKeys(balances) {
+		result = append(result, &Balance{
+			Account: fromKey(key),
+			Amount:  fromDecimal(balances[key]),
+		})
+	}
+	return result
+}
+
+func fromDiagnostic(diagnostic *types.Diagnostic) *Diagnostic {
+	return &Diagnostic{
+		Signature:     trimZero(diagnostic.Signature[:]),
+		Path:          fromPath(diagnostic.Path),
+		Account:       fromKey(diagnostic.Account),
+		Program:       fromKey(diagnostic.Program),
+		Discriminator: diagnostic.Discriminator,
+		Kind:          diagnostic.Kind,
+		Message:       diagnostic.Message,
+	}
+}
+
+func (c *fromConverter) event(event types.Event) *Event {
+	switch event := event.(type) {
+	case *types.CreatePool:
+		return &Event{Event: &Event_CreatePool{CreatePool: &CreatePool{
+			Dex:     fromKey(event.Dex),
+			Pool:    fromKey(event.Pool),
+			User:    fromKey(event.User),
+			TokenA:  fromKey(event.TokenA),
+			TokenB:  fromKey(event.TokenB),
+			TokenLp: fromKey(event.TokenLP),
+			VaultA:  fromKey(event.VaultA),
+			VaultB:  fromKey(event.VaultB),
+			VaultLp: fromKey(event.VaultLP),
+		}}}
+	case *types.AddLiquidity:
+		return &Event{Event: &Event_AddLiquidity{AddLiquidity: &AddLiquidity{
+			Dex:            fromKey(event.Dex),
+			Pool:           fromKey(event.Pool),
+			User:           fromKey(event.User),
+			TokenATransfer: fromTransfer(event.TokenATransfer),
+			TokenBTransfer: fromTransfer(event.TokenBTransfer),
+			TokenLpMint:    fromMintTo(event.TokenLpMint),
+		}}}
+	case *types.RemoveLiquidity:
+		return &Event{Event: &Event_RemoveLiquidity{RemoveLiquidity: &RemoveLiquidity{
+			Dex:            fromKey(event.Dex),
+			Pool:           fromKey(event.Pool),
+			User:           fromKey(event.User),
+			TokenATransfer: fromTransfer(event.TokenATransfer),
+			TokenBTransfer: fromTransfer(event.TokenBTransfer),
+			TokenLpBurn:    fromBurn(event.TokenLpBurn),
+		}}}
+	case *types.Swap:
+		return &Event{Event: &Event_Swap{Swap: fromSwap(event)}}
+	case *types.Route:
+		route := &Route{
+			Router: fromKey(event.Router),
+			User:   fromKey(event.User),
+		}
+		for _, step := range event.RouteSteps {
+			routeStep := &RouteStep{Dex: fromKey(step.Dex)}
+			if step.Swap != nil {
+				routeStep.Swap = fromSwap(step.Swap)
+			}
+			if step.RoutePlan != nil {
+				routeStep.RoutePlan = &RoutePlan{}
+			}
+			if step.SwapEvent != nil {
+				routeStep.SwapEvent = fromSwapEvent(step.SwapEvent)
+			}
+			route.RouteSteps = append(route.RouteSteps, routeStep)
+		}
+		return &Event{Event: &Event_Route{Route: route}}
+	case *types.Transfer:
+		return &Event{Event: &Event_Transfer{Transfer: fromTransfer(event)}}
+	case *types.MintTo:
+		return &Event{Event: &Event_MintTo{MintTo: fromMintTo(event)}}
+	case *types.Burn:
+		return &Event{Event: &Event_Burn{Burn: fromBurn(event)}}
+	case *types.Initialize:
+		return &Event{Event: &Event_Initialize{Initialize: &Initialize{
+			ProgramId: fromKey(event.ProgramId),
+			Account:   fromKey(event.Account),
+			Owner:     fromKey(event.Owner),
+			Mint:      fromKey(event.Mint),
+		}}}
+	case *types.MemeCreate:
+		return &Event{Event: &Event_MemeCreate{MemeCreate: &MemeCreate{
+			Dex:                    fromKey(event.Dex),
+			Mint:                   fromKey(event.Mint),
+			User:                   fromKey(event.User),
+			BondingCurve:           fromKey(event.BondingCurve),
+			AssociatedBondingCurve: fromKey(event.AssociatedBondingCurve),
+			MintTo:                 fromMintTo(event.MintTo),
+		}}}
+	case *types.MemeBuy:
+		return &Event{Event: &Event_MemeBuy{MemeBuy: &MemeBuy{
+			Dex:                    fromKey(event.Dex),
+			Mint:                   fromKey(event.Mint),
+			User:                   fromKey(event.User),
+			BondingCurve:           fromKey(event.BondingCurve),
+			AssociatedBondingCurve: fromKey(event.AssociatedBondingCurve),
+			MintTransfer:           fromTransfer(event.MintTransfer),
+			SolTransfer:            fromTransfer(event.SolTransfer),
+			FeeTransfer:            fromTransfer(event.FeeTransfer),
+			Amounts:                fromSwapAmounts(&event.SwapAmounts),
+		}}}
+	case *types.MemeSell:
+		return &Event{Event: &Event_MemeSell{MemeSell: &MemeSell{
+			Dex:                    fromKey(event.Dex),
+			Mint:                   fromKey(event.Mint),
+			User:                   fromKey(event.User),
+			BondingCurve:           fromKey(event.BondingCurve),
+			AssociatedBondingCurve: fromKey(event.AssociatedBondingCurve),
+			MintTransfer:           fromTransfer(event.MintTransfer),
+			Amounts:                fromSwapAmounts(&event.SwapAmounts),
+		}}}
+	case *types.UnknownInstruction:
+		unknown := &UnknownInstruction{
+			ProgramId:      fromKey(event.ProgramId),
+			ProgramName:    event.ProgramName,
+			Discriminator1: event.Discriminator1,
+			Discriminator4: event.Discriminator4,
+			Discriminator8: event.Discriminator8,
+			DataLength:     int64(event.DataLength),
+		}
+		for _, account := range event.Accounts {
+			// the zero key is kept as 32 zero bytes, so that the accounts keep their positions
+			unknown.Accounts = append(unknown.Accounts, account.Bytes())
+		}
+		return &Event{Event: &Event_UnknownInstruction{UnknownInstruction: unknown}}
+	case *types.Pool:
+		return &Event{Event: &Event_Pool{Pool: &Pool{
+			Dex:      fromKey(event.Dex),
+			Hash:     fromKey(event.Hash),
+			MintA:    fromKey(event.MintA),
+			MintB:    fromKey(event.MintB),
+			MintLp:   fromKey(event.MintLp),
+			VaultA:   fromKey(event.VaultA),
+			VaultB:   fromKey(event.VaultB),
+			ReserveA: event.ReserveA,
+			ReserveB: event.ReserveB,
+		}}}
+	case *types.MemeCreateEvent:
+		return &Event{Event: &Event_MemeCreateEvent{MemeCreateEvent: &MemeCreateEvent{
+			ProgramId:    fromKey(event.ProgramId),
+			Name:         event.Name,
+			Symbol:       event.Symbol,
+			Uri:          event.Uri,
+			Mint:         fromKey(event.Mint),
+			BondingCurve: fromKey(event.BondingCurve),
+			User:         fromKey(event.User),
+		}}}
+	case *types.MemeBuyEvent:
+		return &Event{Event: &Event_MemeBuyEvent{MemeBuyEvent: &MemeBuyEvent{
+			ProgramId:            fromKey(event.ProgramId),
+			Mint:                 fromKey(event.Mint),
+			SolAmount:            event.SolAmount,
+			TokenAmount:          event.TokenAmount,
+			IsBuy:                event.IsBuy,
+			User:                 fromKey(event.User),
+			Timestamp:            event.Timestamp,
+			VirtualSolReserves:   event.VirtualSolReserves,
+			VirtualTokenReserves: event.VirtualTokenReserves,
+		}}}
+	case *types.MemeSellEvent:
+		return &Event{Event: &Event_MemeSellEvent{MemeSellEvent: &MemeSellEvent{
+			ProgramId:            fromKey(event.ProgramId),
+			Mint:                 fromKey(event.Mint),
+			SolAmount:            event.SolAmount,
+			TokenAmount:          event.TokenAmount,
+			IsBuy:                event.IsBuy,
+			User:                 fromKey(event.User),
+			Timestamp:            event.Timestamp,
+			VirtualSolReserves:   event.VirtualSolReserves,
+			VirtualTokenReserves: event.VirtualTokenReserves,
+		}}}
+	case *types.SwapEvent:
+		return &Event{Event: &Event_SwapEvent{SwapEvent: fromSwapEvent(event)}}
+	case *types.DlmmSwapEvent:
+		return &Event{Event: &Event_DlmmSwapEvent{DlmmSwapEvent: &DlmmSwapEvent{
+			ProgramId:   fromKey(event.ProgramId),
+			LbPair:      fromKey(event.LbPair),
+			From:        fromKey(event.From),
+			StartBinId:  event.StartBinId,
+			EndBinId:    event.EndBinId,
+			AmountIn:    event.AmountIn,
+			AmountOut:   event.AmountOut,
+			SwapForY:    event.SwapForY,
+			Fee:         event.Fee,
+			ProtocolFee: event.ProtocolFee,
+			FeeBps:      fromDecimal(event.FeeBps),
+			HostFee:     event.HostFee,
+		}}}
+	case *types.ClmmSwapEvent:
+		return &Event{Event: &Event_ClmmSwapEvent{ClmmSwapEvent: &ClmmSwapEvent{
+			ProgramId:     fromKey(event.ProgramId),
+			PoolState:     fromKey(event.PoolState),
+			Sender:        fromKey(event.Sender),
+			TokenAccount0: fromKey(event.TokenAccount0),
+			TokenAccount1: fromKey(event.TokenAccount1),
+			Amount0:       event.Amount0,
+			TransferFee0:  event.TransferFee0,
+			Amount1:       event.Amount1,
+			TransferFee1:  event.TransferFee1,
+			ZeroForOne:    event.ZeroForOne,
+			SqrtPriceX64:  fromDecimal(event.SqrtPriceX64),
+			Liquidity:     fromDecimal(event.Liquidity),
+			Tick:          event.Tick,
+		}}}
+	case *types.CpSwapEvent:
+		return &Event{Event: &Event_CpSwapEvent{CpSwapEvent: &CpSwapEvent{
+			ProgramId:         fromKey(event.ProgramId),
+			PoolId:            fromKey(event.PoolId),
+			InputVaultBefore:  event.InputVaultBefore,
+			OutputVaultBefore: event.OutputVaultBefore,
+			InputAmount:       event.InputAmount,
+			OutputAmount:      event.OutputAmount,
+			InputTransferFee:  event.InputTransferFee,
+			OutputTransferFee: event.OutputTransferFee,
+			BaseInput:         event.BaseInput,
+		}}}
+	case *types.DecodedInstruction:
+		decoded := &DecodedInstruction{
+			ProgramId: fromKey(event.ProgramId),
+			Name:      event.Name,
+		}
+		if event.Args != nil {
+			decoded.Args = c.json(event.Args)
+		}
+		if event.Accounts != nil {
+			decoded.Accounts = make(map[string][]byte, len(event.Accounts))
+			for name, account := range event.Accounts {
+				decoded.Accounts[name] = fromKey(account)
+			}
+		}
+		return &Event{Event: &Event_DecodedInstruction{DecodedInstruction: decoded}}
+	case *types.DecodedEvent:
+		decoded := &DecodedEvent{
+			ProgramId: fromKey(event.ProgramId),
+			Name:      event.Name,
+		}
+		if event.Fields != nil {
+			decoded.Fields = c.json(event.Fields)
+		}
+		return &Event{Event: &Event_DecodedEvent{DecodedEvent: decoded}}
+	default:
+		data, err := types.MarshalEvent(event)
+		if err != nil {
+			c.fail(err)
+			return &Event{}
+		}
+		return &Event{Event: &Event_Other{Other: &OtherEvent{Kind: event.Kind(), Json: data}}}
+	}
+}
+
+func fromSwap(swap *types.Swap) *Swap {
+	return &Swap{
+		Dex:            fromKey(swap.Dex),
+		Pool:           fromKey(swap.Pool),
+		User:           fromKey(swap.User),
+		InputTransfer:  fromTransfer(swap.InputTransfer),
+		OutputTransfer: fromTransfer(swap.OutputTransfer),
+		Amounts:        fromSwapAmounts(&swap.SwapAmounts),
+	}
+}
+
+func fromSwapEvent(event *types.SwapEvent) *SwapEvent {
+	return &SwapEvent{
+		ProgramId:      fromKey(event.ProgramId),
+		Amm:            fromKey(event.Amm),
+		InputMint:      fromKey(event.InputMint),
+		InputAmount:    event.InputAmount,
+		InputDecimals:  uint32(event.InputDecimals),
+		InputUiAmount:  fromDecimal(event.InputUiAmount),
+		OutputMint:     fromKey(event.OutputMint),
+		OutputAmount:   event.OutputAmount,
+		OutputDecimals: uint32(event.OutputDecimals),
+		OutputUiAmount: fromDecimal(event.OutputUiAmount),
+	}
+}
+
+// fromSwapAmounts is nil until the amounts are filled
+func fromSwapAmounts(amounts *types.SwapAmounts) *SwapAmounts {
+	if amounts.Source == "" && amounts.AmountIn == 0 && amounts.AmountOut == 0 {
+		return nil
+	}
+	return &SwapAmounts{
+		MintIn:    fromKey(amounts.MintIn),
+		MintOut:   fromKey(amounts.MintOut),
+		AmountIn:  amounts.AmountIn,
+		AmountOut: amounts.AmountOut,
+		Price:     fromDecimal(amounts.Price),
+		Source:    amounts.Source,
+	}
+}
+
+func fromTransfer(transfer *types.Transfer) *Transfer {
+	if transfer == nil {
+		return nil
+	}
+	return &Transfer{
+		ProgramId: fromKey(transfer.ProgramId),
+		Mint:      fromKey(transfer.Mint),
+		From:      fromKey(transfer.From),
+		To:        fromKey(transfer.To),
+		Amount:    transfer.Amount,
+		Decimals:  uint32(transfer.Decimals),
+		UiAmount:  fromDecimal(transfer.UiAmount),
+	}
+}
+
+func fromMintTo(mintTo *types.MintTo) *MintTo {
+	if mintTo == nil {
+		return nil
+	}
+	return &MintTo{
+		ProgramId: fromKey(mintTo.ProgramId),
+		Mint:      fromKey(mintTo.Mint),
+		Account:   fromKey(mintTo.Account),
+		Amount:    mintTo.Amount,
+		Decimals:  uint32(mintTo.Decimals),
+		UiAmount:  fromDecimal(mintTo.UiAmount),
+	}
+}
+
+func fromBurn(burn *types.Burn) *Burn {
+	if burn == nil {
+		return nil
+	}
+	return &Burn{
+		ProgramId: fromKey(burn.ProgramId),
+		Mint:      fromKey(burn.Mint),
+		Account:   fromKey(burn.Account),
+		Amount:    burn.Amount,
+		Decimals:  uint32(burn.Decimals),
+		UiAmount:  fromDecimal(burn.UiAmount),
+	}
+}
+
+// fromKey is empty for the zero key
+func fromKey(key solana.PublicKey) []byte {
+	if key.IsZero() {
+		return nil
+	}
+	return key.Bytes()
+}
+
+// fromDecimal is empty for zero
+func fromDecimal(d decimal.Decimal) string {
+	if d.IsZero() {
+		return ""
+	}
+	return d.String()
+}
+
+func fromPath(path []int) []int64 {
+	var result []int64
+	for _, seq := range path {
+		result = append(result, int64(seq))
+	}
+	return result
+}
+
+// trimZero is empty for an all zero signature or hash
+func trimZero(v []byte) []byte {
+	for _, b := range v {
+		if b != 0 {
+			return append([]byte(nil), v...)
+		}
+	}
+	return nil
+}
+
+// sortedKeys are the keys of a map in byte order
+func sortedKeys[V any](m map[solana.PublicKey]V) []solana.PublicKey {
+	keys := make([]solana.PublicKey, 0, len(m))
+	for key := range m {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool { return bytes.Compare(keys[i][:], keys[j][:]) < 0 })
+	return keys
+}
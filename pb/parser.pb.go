@@ -0,0 +1,4361 @@
+// Schema of the parsed blocks, transactions & events of solana-parser.
+//
+// Keys, signatures & hashes are raw bytes, 32 bytes for keys & hashes and 64 bytes for signatures,
+// an empty key is the zero key. Decimals are strings so that no precision is lost, an empty decimal is zero.
+// Fields are only ever added, a breaking change goes to a new package version.
+
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// versions:
+// 	protoc-gen-go v1.36.6
+// 	protoc        (unknown)
+// source: parser.proto
+
+package pb
+
+import (
+	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
+	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
+	reflect "reflect"
+	sync "sync"
+	unsafe "unsafe"
+)
+
+const (
+	// Verify that this generated code is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(20 - protoimpl.MinVersion)
+	// Verify that runtime/protoimpl is sufficiently up-to-date.
+	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
+)
+
+type Block struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time          uint64                 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Slot          uint64                 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Transactions  []*Transaction         `protobuf:"bytes,4,rep,name=transactions,proto3" json:"transactions,omitempty"`
+	Diagnostics   []*Diagnostic          `protobuf:"bytes,5,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Block) Reset() {
+	*x = Block{}
+	mi := &file_parser_proto_msgTypes[0]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Block) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Block) ProtoMessage() {}
+
+func (x *Block) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[0]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Block.ProtoReflect.Descriptor instead.
+func (*Block) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{0}
+}
+
+func (x *Block) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Block) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Block) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Block) GetTransactions() []*Transaction {
+	if x != nil {
+		return x.Transactions
+	}
+	return nil
+}
+
+func (x *Block) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+type Transaction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Hash          []byte                 `protobuf:"bytes,1,opt,name=hash,proto3" json:"hash,omitempty"`
+	Time          uint64                 `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	Slot          uint64                 `protobuf:"varint,3,opt,name=slot,proto3" json:"slot,omitempty"`
+	Instructions  []*Instruction         `protobuf:"bytes,4,rep,name=instructions,proto3" json:"instructions,omitempty"`
+	Meta          *Meta                  `protobuf:"bytes,5,opt,name=meta,proto3" json:"meta,omitempty"`
+	Seq           int64                  `protobuf:"varint,6,opt,name=seq,proto3" json:"seq,omitempty"`
+	Failed        bool                   `protobuf:"varint,7,opt,name=failed,proto3" json:"failed,omitempty"`
+	Diagnostics   []*Diagnostic          `protobuf:"bytes,8,rep,name=diagnostics,proto3" json:"diagnostics,omitempty"`
+	Actions       []*PrimaryAction       `protobuf:"bytes,9,rep,name=actions,proto3" json:"actions,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transaction) Reset() {
+	*x = Transaction{}
+	mi := &file_parser_proto_msgTypes[1]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transaction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transaction) ProtoMessage() {}
+
+func (x *Transaction) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[1]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transaction.ProtoReflect.Descriptor instead.
+func (*Transaction) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{1}
+}
+
+func (x *Transaction) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Transaction) GetTime() uint64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *Transaction) GetSlot() uint64 {
+	if x != nil {
+		return x.Slot
+	}
+	return 0
+}
+
+func (x *Transaction) GetInstructions() []*Instruction {
+	if x != nil {
+		return x.Instructions
+	}
+	return nil
+}
+
+func (x *Transaction) GetMeta() *Meta {
+	if x != nil {
+		return x.Meta
+	}
+	return nil
+}
+
+func (x *Transaction) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Transaction) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *Transaction) GetDiagnostics() []*Diagnostic {
+	if x != nil {
+		return x.Diagnostics
+	}
+	return nil
+}
+
+func (x *Transaction) GetActions() []*PrimaryAction {
+	if x != nil {
+		return x.Actions
+	}
+	return nil
+}
+
+type AccountMeta struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	PublicKey     []byte                 `protobuf:"bytes,1,opt,name=public_key,json=publicKey,proto3" json:"public_key,omitempty"`
+	IsWritable    bool                   `protobuf:"varint,2,opt,name=is_writable,json=isWritable,proto3" json:"is_writable,omitempty"`
+	IsSigner      bool                   `protobuf:"varint,3,opt,name=is_signer,json=isSigner,proto3" json:"is_signer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AccountMeta) Reset() {
+	*x = AccountMeta{}
+	mi := &file_parser_proto_msgTypes[2]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AccountMeta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AccountMeta) ProtoMessage() {}
+
+func (x *AccountMeta) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[2]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AccountMeta.ProtoReflect.Descriptor instead.
+func (*AccountMeta) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{2}
+}
+
+func (x *AccountMeta) GetPublicKey() []byte {
+	if x != nil {
+		return x.PublicKey
+	}
+	return nil
+}
+
+func (x *AccountMeta) GetIsWritable() bool {
+	if x != nil {
+		return x.IsWritable
+	}
+	return false
+}
+
+func (x *AccountMeta) GetIsSigner() bool {
+	if x != nil {
+		return x.IsSigner
+	}
+	return false
+}
+
+// Instruction is one node of the instruction tree, the parent of an instruction is the enclosing message
+type Instruction struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	Seq       int64                  `protobuf:"varint,1,opt,name=seq,proto3" json:"seq,omitempty"`
+	Path      []int64                `protobuf:"varint,2,rep,packed,name=path,proto3" json:"path,omitempty"`
+	Depth     int64                  `protobuf:"varint,3,opt,name=depth,proto3" json:"depth,omitempty"`
+	ProgramId []byte                 `protobuf:"bytes,4,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Accounts  []*AccountMeta         `protobuf:"bytes,5,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	Data      []byte                 `protobuf:"bytes,6,opt,name=data,proto3" json:"data,omitempty"`
+	// parsed_instruction is the json of the decoded instruction of the program bindings
+	ParsedInstruction []byte         `protobuf:"bytes,7,opt,name=parsed_instruction,json=parsedInstruction,proto3" json:"parsed_instruction,omitempty"`
+	Events            []*Event       `protobuf:"bytes,8,rep,name=events,proto3" json:"events,omitempty"`
+	Receipts          []*Event       `protobuf:"bytes,9,rep,name=receipts,proto3" json:"receipts,omitempty"`
+	Children          []*Instruction `protobuf:"bytes,10,rep,name=children,proto3" json:"children,omitempty"`
+	Logs              []string       `protobuf:"bytes,11,rep,name=logs,proto3" json:"logs,omitempty"`
+	ComputeUnits      uint64         `protobuf:"varint,12,opt,name=compute_units,json=computeUnits,proto3" json:"compute_units,omitempty"`
+	Success           bool           `protobuf:"varint,13,opt,name=success,proto3" json:"success,omitempty"`
+	Failed            bool           `protobuf:"varint,14,opt,name=failed,proto3" json:"failed,omitempty"`
+	Error             string         `protobuf:"bytes,15,opt,name=error,proto3" json:"error,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *Instruction) Reset() {
+	*x = Instruction{}
+	mi := &file_parser_proto_msgTypes[3]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Instruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Instruction) ProtoMessage() {}
+
+func (x *Instruction) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[3]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Instruction.ProtoReflect.Descriptor instead.
+func (*Instruction) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{3}
+}
+
+func (x *Instruction) GetSeq() int64 {
+	if x != nil {
+		return x.Seq
+	}
+	return 0
+}
+
+func (x *Instruction) GetPath() []int64 {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Instruction) GetDepth() int64 {
+	if x != nil {
+		return x.Depth
+	}
+	return 0
+}
+
+func (x *Instruction) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *Instruction) GetAccounts() []*AccountMeta {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *Instruction) GetData() []byte {
+	if x != nil {
+		return x.Data
+	}
+	return nil
+}
+
+func (x *Instruction) GetParsedInstruction() []byte {
+	if x != nil {
+		return x.ParsedInstruction
+	}
+	return nil
+}
+
+func (x *Instruction) GetEvents() []*Event {
+	if x != nil {
+		return x.Events
+	}
+	return nil
+}
+
+func (x *Instruction) GetReceipts() []*Event {
+	if x != nil {
+		return x.Receipts
+	}
+	return nil
+}
+
+func (x *Instruction) GetChildren() []*Instruction {
+	if x != nil {
+		return x.Children
+	}
+	return nil
+}
+
+func (x *Instruction) GetLogs() []string {
+	if x != nil {
+		return x.Logs
+	}
+	return nil
+}
+
+func (x *Instruction) GetComputeUnits() uint64 {
+	if x != nil {
+		return x.ComputeUnits
+	}
+	return 0
+}
+
+func (x *Instruction) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *Instruction) GetFailed() bool {
+	if x != nil {
+		return x.Failed
+	}
+	return false
+}
+
+func (x *Instruction) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+type TokenAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       []byte                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Owner         []byte                 `protobuf:"bytes,2,opt,name=owner,proto3" json:"owner,omitempty"`
+	ProgramId     []byte                 `protobuf:"bytes,3,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint          []byte                 `protobuf:"bytes,4,opt,name=mint,proto3" json:"mint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TokenAccount) Reset() {
+	*x = TokenAccount{}
+	mi := &file_parser_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TokenAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TokenAccount) ProtoMessage() {}
+
+func (x *TokenAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TokenAccount.ProtoReflect.Descriptor instead.
+func (*TokenAccount) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *TokenAccount) GetAccount() []byte {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *TokenAccount) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *TokenAccount) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *TokenAccount) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+type MintAccount struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Mint          []byte                 `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,2,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintAccount) Reset() {
+	*x = MintAccount{}
+	mi := &file_parser_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintAccount) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintAccount) ProtoMessage() {}
+
+func (x *MintAccount) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintAccount.ProtoReflect.Descriptor instead.
+func (*MintAccount) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *MintAccount) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MintAccount) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+type Balance struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Account       []byte                 `protobuf:"bytes,1,opt,name=account,proto3" json:"account,omitempty"`
+	Amount        string                 `protobuf:"bytes,2,opt,name=amount,proto3" json:"amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Balance) Reset() {
+	*x = Balance{}
+	mi := &file_parser_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Balance) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Balance) ProtoMessage() {}
+
+func (x *Balance) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Balance.ProtoReflect.Descriptor instead.
+func (*Balance) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *Balance) GetAccount() []byte {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Balance) GetAmount() string {
+	if x != nil {
+		return x.Amount
+	}
+	return ""
+}
+
+type BalanceChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Owner         []byte                 `protobuf:"bytes,1,opt,name=owner,proto3" json:"owner,omitempty"`
+	Mint          []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Pre           string                 `protobuf:"bytes,3,opt,name=pre,proto3" json:"pre,omitempty"`
+	Post          string                 `protobuf:"bytes,4,opt,name=post,proto3" json:"post,omitempty"`
+	Change        string                 `protobuf:"bytes,5,opt,name=change,proto3" json:"change,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *BalanceChange) Reset() {
+	*x = BalanceChange{}
+	mi := &file_parser_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *BalanceChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*BalanceChange) ProtoMessage() {}
+
+func (x *BalanceChange) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use BalanceChange.ProtoReflect.Descriptor instead.
+func (*BalanceChange) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *BalanceChange) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *BalanceChange) GetPre() string {
+	if x != nil {
+		return x.Pre
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetPost() string {
+	if x != nil {
+		return x.Post
+	}
+	return ""
+}
+
+func (x *BalanceChange) GetChange() string {
+	if x != nil {
+		return x.Change
+	}
+	return ""
+}
+
+type Meta struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Accounts         []*AccountMeta         `protobuf:"bytes,1,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	TokenAccounts    []*TokenAccount        `protobuf:"bytes,2,rep,name=token_accounts,json=tokenAccounts,proto3" json:"token_accounts,omitempty"`
+	MintAccounts     []*MintAccount         `protobuf:"bytes,3,rep,name=mint_accounts,json=mintAccounts,proto3" json:"mint_accounts,omitempty"`
+	TokenPreBalance  []*Balance             `protobuf:"bytes,4,rep,name=token_pre_balance,json=tokenPreBalance,proto3" json:"token_pre_balance,omitempty"`
+	TokenPostBalance []*Balance             `protobuf:"bytes,5,rep,name=token_post_balance,json=tokenPostBalance,proto3" json:"token_post_balance,omitempty"`
+	SolPreBalance    []*Balance             `protobuf:"bytes,6,rep,name=sol_pre_balance,json=solPreBalance,proto3" json:"sol_pre_balance,omitempty"`
+	SolPostBalance   []*Balance             `protobuf:"bytes,7,rep,name=sol_post_balance,json=solPostBalance,proto3" json:"sol_post_balance,omitempty"`
+	SolBalanceDelta  []*Balance             `protobuf:"bytes,8,rep,name=sol_balance_delta,json=solBalanceDelta,proto3" json:"sol_balance_delta,omitempty"`
+	BalanceChanges   []*BalanceChange       `protobuf:"bytes,9,rep,name=balance_changes,json=balanceChanges,proto3" json:"balance_changes,omitempty"`
+	ErrorMessage     []byte                 `protobuf:"bytes,10,opt,name=error_message,json=errorMessage,proto3" json:"error_message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Meta) Reset() {
+	*x = Meta{}
+	mi := &file_parser_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Meta) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Meta) ProtoMessage() {}
+
+func (x *Meta) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Meta.ProtoReflect.Descriptor instead.
+func (*Meta) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *Meta) GetAccounts() []*AccountMeta {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+func (x *Meta) GetTokenAccounts() []*TokenAccount {
+	if x != nil {
+		return x.TokenAccounts
+	}
+	return nil
+}
+
+func (x *Meta) GetMintAccounts() []*MintAccount {
+	if x != nil {
+		return x.MintAccounts
+	}
+	return nil
+}
+
+func (x *Meta) GetTokenPreBalance() []*Balance {
+	if x != nil {
+		return x.TokenPreBalance
+	}
+	return nil
+}
+
+func (x *Meta) GetTokenPostBalance() []*Balance {
+	if x != nil {
+		return x.TokenPostBalance
+	}
+	return nil
+}
+
+func (x *Meta) GetSolPreBalance() []*Balance {
+	if x != nil {
+		return x.SolPreBalance
+	}
+	return nil
+}
+
+func (x *Meta) GetSolPostBalance() []*Balance {
+	if x != nil {
+		return x.SolPostBalance
+	}
+	return nil
+}
+
+func (x *Meta) GetSolBalanceDelta() []*Balance {
+	if x != nil {
+		return x.SolBalanceDelta
+	}
+	return nil
+}
+
+func (x *Meta) GetBalanceChanges() []*BalanceChange {
+	if x != nil {
+		return x.BalanceChanges
+	}
+	return nil
+}
+
+func (x *Meta) GetErrorMessage() []byte {
+	if x != nil {
+		return x.ErrorMessage
+	}
+	return nil
+}
+
+type Diagnostic struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Signature     []byte                 `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+	Path          []int64                `protobuf:"varint,2,rep,packed,name=path,proto3" json:"path,omitempty"`
+	Account       []byte                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Program       []byte                 `protobuf:"bytes,4,opt,name=program,proto3" json:"program,omitempty"`
+	Discriminator string                 `protobuf:"bytes,5,opt,name=discriminator,proto3" json:"discriminator,omitempty"`
+	Kind          string                 `protobuf:"bytes,6,opt,name=kind,proto3" json:"kind,omitempty"`
+	Message       string                 `protobuf:"bytes,7,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Diagnostic) Reset() {
+	*x = Diagnostic{}
+	mi := &file_parser_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Diagnostic) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Diagnostic) ProtoMessage() {}
+
+func (x *Diagnostic) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Diagnostic.ProtoReflect.Descriptor instead.
+func (*Diagnostic) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *Diagnostic) GetSignature() []byte {
+	if x != nil {
+		return x.Signature
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetPath() []int64 {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetAccount() []byte {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetProgram() []byte {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *Diagnostic) GetDiscriminator() string {
+	if x != nil {
+		return x.Discriminator
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *Diagnostic) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type PrimaryAction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Path          []int64                `protobuf:"varint,1,rep,packed,name=path,proto3" json:"path,omitempty"`
+	Program       []byte                 `protobuf:"bytes,2,opt,name=program,proto3" json:"program,omitempty"`
+	Name          string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Type          string                 `protobuf:"bytes,4,opt,name=type,proto3" json:"type,omitempty"`
+	Priority      int64                  `protobuf:"varint,5,opt,name=priority,proto3" json:"priority,omitempty"`
+	Action        *Event                 `protobuf:"bytes,6,opt,name=action,proto3" json:"action,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PrimaryAction) Reset() {
+	*x = PrimaryAction{}
+	mi := &file_parser_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PrimaryAction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PrimaryAction) ProtoMessage() {}
+
+func (x *PrimaryAction) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PrimaryAction.ProtoReflect.Descriptor instead.
+func (*PrimaryAction) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *PrimaryAction) GetPath() []int64 {
+	if x != nil {
+		return x.Path
+	}
+	return nil
+}
+
+func (x *PrimaryAction) GetProgram() []byte {
+	if x != nil {
+		return x.Program
+	}
+	return nil
+}
+
+func (x *PrimaryAction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *PrimaryAction) GetType() string {
+	if x != nil {
+		return x.Type
+	}
+	return ""
+}
+
+func (x *PrimaryAction) GetPriority() int64 {
+	if x != nil {
+		return x.Priority
+	}
+	return 0
+}
+
+func (x *PrimaryAction) GetAction() *Event {
+	if x != nil {
+		return x.Action
+	}
+	return nil
+}
+
+// Event is one event or receipt of an instruction, exactly one of the fields is set
+type Event struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*Event_CreatePool
+	//	*Event_AddLiquidity
+	//	*Event_RemoveLiquidity
+	//	*Event_Swap
+	//	*Event_Route
+	//	*Event_Transfer
+	//	*Event_MintTo
+	//	*Event_Burn
+	//	*Event_Initialize
+	//	*Event_MemeCreate
+	//	*Event_MemeBuy
+	//	*Event_MemeSell
+	//	*Event_UnknownInstruction
+	//	*Event_Pool
+	//	*Event_MemeCreateEvent
+	//	*Event_MemeBuyEvent
+	//	*Event_MemeSellEvent
+	//	*Event_SwapEvent
+	//	*Event_DlmmSwapEvent
+	//	*Event_ClmmSwapEvent
+	//	*Event_CpSwapEvent
+	//	*Event_DecodedInstruction
+	//	*Event_DecodedEvent
+	//	*Event_Other
+	Event         isEvent_Event `protobuf_oneof:"event"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Event) Reset() {
+	*x = Event{}
+	mi := &file_parser_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Event) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Event) ProtoMessage() {}
+
+func (x *Event) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[11]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Event.ProtoReflect.Descriptor instead.
+func (*Event) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *Event) GetEvent() isEvent_Event {
+	if x != nil {
+		return x.Event
+	}
+	return nil
+}
+
+func (x *Event) GetCreatePool() *CreatePool {
+	if x != nil {
+		if x, ok := x.Event.(*Event_CreatePool); ok {
+			return x.CreatePool
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetAddLiquidity() *AddLiquidity {
+	if x != nil {
+		if x, ok := x.Event.(*Event_AddLiquidity); ok {
+			return x.AddLiquidity
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetRemoveLiquidity() *RemoveLiquidity {
+	if x != nil {
+		if x, ok := x.Event.(*Event_RemoveLiquidity); ok {
+			return x.RemoveLiquidity
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSwap() *Swap {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Swap); ok {
+			return x.Swap
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetRoute() *Route {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Route); ok {
+			return x.Route
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetTransfer() *Transfer {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Transfer); ok {
+			return x.Transfer
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMintTo() *MintTo {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MintTo); ok {
+			return x.MintTo
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetBurn() *Burn {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Burn); ok {
+			return x.Burn
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetInitialize() *Initialize {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Initialize); ok {
+			return x.Initialize
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMemeCreate() *MemeCreate {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MemeCreate); ok {
+			return x.MemeCreate
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMemeBuy() *MemeBuy {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MemeBuy); ok {
+			return x.MemeBuy
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMemeSell() *MemeSell {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MemeSell); ok {
+			return x.MemeSell
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetUnknownInstruction() *UnknownInstruction {
+	if x != nil {
+		if x, ok := x.Event.(*Event_UnknownInstruction); ok {
+			return x.UnknownInstruction
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetPool() *Pool {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Pool); ok {
+			return x.Pool
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMemeCreateEvent() *MemeCreateEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MemeCreateEvent); ok {
+			return x.MemeCreateEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMemeBuyEvent() *MemeBuyEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MemeBuyEvent); ok {
+			return x.MemeBuyEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetMemeSellEvent() *MemeSellEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_MemeSellEvent); ok {
+			return x.MemeSellEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetSwapEvent() *SwapEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_SwapEvent); ok {
+			return x.SwapEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDlmmSwapEvent() *DlmmSwapEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_DlmmSwapEvent); ok {
+			return x.DlmmSwapEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetClmmSwapEvent() *ClmmSwapEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_ClmmSwapEvent); ok {
+			return x.ClmmSwapEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetCpSwapEvent() *CpSwapEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_CpSwapEvent); ok {
+			return x.CpSwapEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDecodedInstruction() *DecodedInstruction {
+	if x != nil {
+		if x, ok := x.Event.(*Event_DecodedInstruction); ok {
+			return x.DecodedInstruction
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetDecodedEvent() *DecodedEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_DecodedEvent); ok {
+			return x.DecodedEvent
+		}
+	}
+	return nil
+}
+
+func (x *Event) GetOther() *OtherEvent {
+	if x != nil {
+		if x, ok := x.Event.(*Event_Other); ok {
+			return x.Other
+		}
+	}
+	return nil
+}
+
+type isEvent_Event interface {
+	isEvent_Event()
+}
+
+type Event_CreatePool struct {
+	CreatePool *CreatePool `protobuf:"bytes,1,opt,name=create_pool,json=createPool,proto3,oneof"`
+}
+
+type Event_AddLiquidity struct {
+	AddLiquidity *AddLiquidity `protobuf:"bytes,2,opt,name=add_liquidity,json=addLiquidity,proto3,oneof"`
+}
+
+type Event_RemoveLiquidity struct {
+	RemoveLiquidity *RemoveLiquidity `protobuf:"bytes,3,opt,name=remove_liquidity,json=removeLiquidity,proto3,oneof"`
+}
+
+type Event_Swap struct {
+	Swap *Swap `protobuf:"bytes,4,opt,name=swap,proto3,oneof"`
+}
+
+type Event_Route struct {
+	Route *Route `protobuf:"bytes,5,opt,name=route,proto3,oneof"`
+}
+
+type Event_Transfer struct {
+	Transfer *Transfer `protobuf:"bytes,6,opt,name=transfer,proto3,oneof"`
+}
+
+type Event_MintTo struct {
+	MintTo *MintTo `protobuf:"bytes,7,opt,name=mint_to,json=mintTo,proto3,oneof"`
+}
+
+type Event_Burn struct {
+	Burn *Burn `protobuf:"bytes,8,opt,name=burn,proto3,oneof"`
+}
+
+type Event_Initialize struct {
+	Initialize *Initialize `protobuf:"bytes,9,opt,name=initialize,proto3,oneof"`
+}
+
+type Event_MemeCreate struct {
+	MemeCreate *MemeCreate `protobuf:"bytes,10,opt,name=meme_create,json=memeCreate,proto3,oneof"`
+}
+
+type Event_MemeBuy struct {
+	MemeBuy *MemeBuy `protobuf:"bytes,11,opt,name=meme_buy,json=memeBuy,proto3,oneof"`
+}
+
+type Event_MemeSell struct {
+	MemeSell *MemeSell `protobuf:"bytes,12,opt,name=meme_sell,json=memeSell,proto3,oneof"`
+}
+
+type Event_UnknownInstruction struct {
+	UnknownInstruction *UnknownInstruction `protobuf:"bytes,13,opt,name=unknown_instruction,json=unknownInstruction,proto3,oneof"`
+}
+
+type Event_Pool struct {
+	Pool *Pool `protobuf:"bytes,14,opt,name=pool,proto3,oneof"`
+}
+
+type Event_MemeCreateEvent struct {
+	MemeCreateEvent *MemeCreateEvent `protobuf:"bytes,15,opt,name=meme_create_event,json=memeCreateEvent,proto3,oneof"`
+}
+
+type Event_MemeBuyEvent struct {
+	MemeBuyEvent *MemeBuyEvent `protobuf:"bytes,16,opt,name=meme_buy_event,json=memeBuyEvent,proto3,oneof"`
+}
+
+type Event_MemeSellEvent struct {
+	MemeSellEvent *MemeSellEvent `protobuf:"bytes,17,opt,name=meme_sell_event,json=memeSellEvent,proto3,oneof"`
+}
+
+type Event_SwapEvent struct {
+	SwapEvent *SwapEvent `protobuf:"bytes,18,opt,name=swap_event,json=swapEvent,proto3,oneof"`
+}
+
+type Event_DlmmSwapEvent struct {
+	DlmmSwapEvent *DlmmSwapEvent `protobuf:"bytes,19,opt,name=dlmm_swap_event,json=dlmmSwapEvent,proto3,oneof"`
+}
+
+type Event_ClmmSwapEvent struct {
+	ClmmSwapEvent *ClmmSwapEvent `protobuf:"bytes,20,opt,name=clmm_swap_event,json=clmmSwapEvent,proto3,oneof"`
+}
+
+type Event_CpSwapEvent struct {
+	CpSwapEvent *CpSwapEvent `protobuf:"bytes,21,opt,name=cp_swap_event,json=cpSwapEvent,proto3,oneof"`
+}
+
+type Event_DecodedInstruction struct {
+	DecodedInstruction *DecodedInstruction `protobuf:"bytes,22,opt,name=decoded_instruction,json=decodedInstruction,proto3,oneof"`
+}
+
+type Event_DecodedEvent struct {
+	DecodedEvent *DecodedEvent `protobuf:"bytes,23,opt,name=decoded_event,json=decodedEvent,proto3,oneof"`
+}
+
+type Event_Other struct {
+	// other is an event registered outside of the types package
+	Other *OtherEvent `protobuf:"bytes,100,opt,name=other,proto3,oneof"`
+}
+
+func (*Event_CreatePool) isEvent_Event() {}
+
+func (*Event_AddLiquidity) isEvent_Event() {}
+
+func (*Event_RemoveLiquidity) isEvent_Event() {}
+
+func (*Event_Swap) isEvent_Event() {}
+
+func (*Event_Route) isEvent_Event() {}
+
+func (*Event_Transfer) isEvent_Event() {}
+
+func (*Event_MintTo) isEvent_Event() {}
+
+func (*Event_Burn) isEvent_Event() {}
+
+func (*Event_Initialize) isEvent_Event() {}
+
+func (*Event_MemeCreate) isEvent_Event() {}
+
+func (*Event_MemeBuy) isEvent_Event() {}
+
+func (*Event_MemeSell) isEvent_Event() {}
+
+func (*Event_UnknownInstruction) isEvent_Event() {}
+
+func (*Event_Pool) isEvent_Event() {}
+
+func (*Event_MemeCreateEvent) isEvent_Event() {}
+
+func (*Event_MemeBuyEvent) isEvent_Event() {}
+
+func (*Event_MemeSellEvent) isEvent_Event() {}
+
+func (*Event_SwapEvent) isEvent_Event() {}
+
+func (*Event_DlmmSwapEvent) isEvent_Event() {}
+
+func (*Event_ClmmSwapEvent) isEvent_Event() {}
+
+func (*Event_CpSwapEvent) isEvent_Event() {}
+
+func (*Event_DecodedInstruction) isEvent_Event() {}
+
+func (*Event_DecodedEvent) isEvent_Event() {}
+
+func (*Event_Other) isEvent_Event() {}
+
+// OtherEvent is the json of an event, with its kind in the "type" field
+type OtherEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kind          string                 `protobuf:"bytes,1,opt,name=kind,proto3" json:"kind,omitempty"`
+	Json          []byte                 `protobuf:"bytes,2,opt,name=json,proto3" json:"json,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OtherEvent) Reset() {
+	*x = OtherEvent{}
+	mi := &file_parser_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OtherEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OtherEvent) ProtoMessage() {}
+
+func (x *OtherEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OtherEvent.ProtoReflect.Descriptor instead.
+func (*OtherEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *OtherEvent) GetKind() string {
+	if x != nil {
+		return x.Kind
+	}
+	return ""
+}
+
+func (x *OtherEvent) GetJson() []byte {
+	if x != nil {
+		return x.Json
+	}
+	return nil
+}
+
+type CreatePool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dex           []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Pool          []byte                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	User          []byte                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	TokenA        []byte                 `protobuf:"bytes,4,opt,name=token_a,json=tokenA,proto3" json:"token_a,omitempty"`
+	TokenB        []byte                 `protobuf:"bytes,5,opt,name=token_b,json=tokenB,proto3" json:"token_b,omitempty"`
+	TokenLp       []byte                 `protobuf:"bytes,6,opt,name=token_lp,json=tokenLp,proto3" json:"token_lp,omitempty"`
+	VaultA        []byte                 `protobuf:"bytes,7,opt,name=vault_a,json=vaultA,proto3" json:"vault_a,omitempty"`
+	VaultB        []byte                 `protobuf:"bytes,8,opt,name=vault_b,json=vaultB,proto3" json:"vault_b,omitempty"`
+	VaultLp       []byte                 `protobuf:"bytes,9,opt,name=vault_lp,json=vaultLp,proto3" json:"vault_lp,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreatePool) Reset() {
+	*x = CreatePool{}
+	mi := &file_parser_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreatePool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreatePool) ProtoMessage() {}
+
+func (x *CreatePool) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreatePool.ProtoReflect.Descriptor instead.
+func (*CreatePool) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *CreatePool) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *CreatePool) GetPool() []byte {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *CreatePool) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *CreatePool) GetTokenA() []byte {
+	if x != nil {
+		return x.TokenA
+	}
+	return nil
+}
+
+func (x *CreatePool) GetTokenB() []byte {
+	if x != nil {
+		return x.TokenB
+	}
+	return nil
+}
+
+func (x *CreatePool) GetTokenLp() []byte {
+	if x != nil {
+		return x.TokenLp
+	}
+	return nil
+}
+
+func (x *CreatePool) GetVaultA() []byte {
+	if x != nil {
+		return x.VaultA
+	}
+	return nil
+}
+
+func (x *CreatePool) GetVaultB() []byte {
+	if x != nil {
+		return x.VaultB
+	}
+	return nil
+}
+
+func (x *CreatePool) GetVaultLp() []byte {
+	if x != nil {
+		return x.VaultLp
+	}
+	return nil
+}
+
+type AddLiquidity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Dex            []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Pool           []byte                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	User           []byte                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	TokenATransfer *Transfer              `protobuf:"bytes,4,opt,name=token_a_transfer,json=tokenATransfer,proto3" json:"token_a_transfer,omitempty"`
+	TokenBTransfer *Transfer              `protobuf:"bytes,5,opt,name=token_b_transfer,json=tokenBTransfer,proto3" json:"token_b_transfer,omitempty"`
+	TokenLpMint    *MintTo                `protobuf:"bytes,6,opt,name=token_lp_mint,json=tokenLpMint,proto3" json:"token_lp_mint,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *AddLiquidity) Reset() {
+	*x = AddLiquidity{}
+	mi := &file_parser_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AddLiquidity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AddLiquidity) ProtoMessage() {}
+
+func (x *AddLiquidity) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AddLiquidity.ProtoReflect.Descriptor instead.
+func (*AddLiquidity) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *AddLiquidity) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *AddLiquidity) GetPool() []byte {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *AddLiquidity) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *AddLiquidity) GetTokenATransfer() *Transfer {
+	if x != nil {
+		return x.TokenATransfer
+	}
+	return nil
+}
+
+func (x *AddLiquidity) GetTokenBTransfer() *Transfer {
+	if x != nil {
+		return x.TokenBTransfer
+	}
+	return nil
+}
+
+func (x *AddLiquidity) GetTokenLpMint() *MintTo {
+	if x != nil {
+		return x.TokenLpMint
+	}
+	return nil
+}
+
+type RemoveLiquidity struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Dex            []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Pool           []byte                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	User           []byte                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	TokenATransfer *Transfer              `protobuf:"bytes,4,opt,name=token_a_transfer,json=tokenATransfer,proto3" json:"token_a_transfer,omitempty"`
+	TokenBTransfer *Transfer              `protobuf:"bytes,5,opt,name=token_b_transfer,json=tokenBTransfer,proto3" json:"token_b_transfer,omitempty"`
+	TokenLpBurn    *Burn                  `protobuf:"bytes,6,opt,name=token_lp_burn,json=tokenLpBurn,proto3" json:"token_lp_burn,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *RemoveLiquidity) Reset() {
+	*x = RemoveLiquidity{}
+	mi := &file_parser_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RemoveLiquidity) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RemoveLiquidity) ProtoMessage() {}
+
+func (x *RemoveLiquidity) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RemoveLiquidity.ProtoReflect.Descriptor instead.
+func (*RemoveLiquidity) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *RemoveLiquidity) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *RemoveLiquidity) GetPool() []byte {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *RemoveLiquidity) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *RemoveLiquidity) GetTokenATransfer() *Transfer {
+	if x != nil {
+		return x.TokenATransfer
+	}
+	return nil
+}
+
+func (x *RemoveLiquidity) GetTokenBTransfer() *Transfer {
+	if x != nil {
+		return x.TokenBTransfer
+	}
+	return nil
+}
+
+func (x *RemoveLiquidity) GetTokenLpBurn() *Burn {
+	if x != nil {
+		return x.TokenLpBurn
+	}
+	return nil
+}
+
+type SwapAmounts struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	MintIn        []byte                 `protobuf:"bytes,1,opt,name=mint_in,json=mintIn,proto3" json:"mint_in,omitempty"`
+	MintOut       []byte                 `protobuf:"bytes,2,opt,name=mint_out,json=mintOut,proto3" json:"mint_out,omitempty"`
+	AmountIn      uint64                 `protobuf:"varint,3,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	AmountOut     uint64                 `protobuf:"varint,4,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	Price         string                 `protobuf:"bytes,5,opt,name=price,proto3" json:"price,omitempty"`
+	Source        string                 `protobuf:"bytes,6,opt,name=source,proto3" json:"source,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *SwapAmounts) Reset() {
+	*x = SwapAmounts{}
+	mi := &file_parser_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapAmounts) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapAmounts) ProtoMessage() {}
+
+func (x *SwapAmounts) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapAmounts.ProtoReflect.Descriptor instead.
+func (*SwapAmounts) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *SwapAmounts) GetMintIn() []byte {
+	if x != nil {
+		return x.MintIn
+	}
+	return nil
+}
+
+func (x *SwapAmounts) GetMintOut() []byte {
+	if x != nil {
+		return x.MintOut
+	}
+	return nil
+}
+
+func (x *SwapAmounts) GetAmountIn() uint64 {
+	if x != nil {
+		return x.AmountIn
+	}
+	return 0
+}
+
+func (x *SwapAmounts) GetAmountOut() uint64 {
+	if x != nil {
+		return x.AmountOut
+	}
+	return 0
+}
+
+func (x *SwapAmounts) GetPrice() string {
+	if x != nil {
+		return x.Price
+	}
+	return ""
+}
+
+func (x *SwapAmounts) GetSource() string {
+	if x != nil {
+		return x.Source
+	}
+	return ""
+}
+
+type Swap struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Dex            []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Pool           []byte                 `protobuf:"bytes,2,opt,name=pool,proto3" json:"pool,omitempty"`
+	User           []byte                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	InputTransfer  *Transfer              `protobuf:"bytes,4,opt,name=input_transfer,json=inputTransfer,proto3" json:"input_transfer,omitempty"`
+	OutputTransfer *Transfer              `protobuf:"bytes,5,opt,name=output_transfer,json=outputTransfer,proto3" json:"output_transfer,omitempty"`
+	Amounts        *SwapAmounts           `protobuf:"bytes,6,opt,name=amounts,proto3" json:"amounts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Swap) Reset() {
+	*x = Swap{}
+	mi := &file_parser_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Swap) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Swap) ProtoMessage() {}
+
+func (x *Swap) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Swap.ProtoReflect.Descriptor instead.
+func (*Swap) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *Swap) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *Swap) GetPool() []byte {
+	if x != nil {
+		return x.Pool
+	}
+	return nil
+}
+
+func (x *Swap) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Swap) GetInputTransfer() *Transfer {
+	if x != nil {
+		return x.InputTransfer
+	}
+	return nil
+}
+
+func (x *Swap) GetOutputTransfer() *Transfer {
+	if x != nil {
+		return x.OutputTransfer
+	}
+	return nil
+}
+
+func (x *Swap) GetAmounts() *SwapAmounts {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type RoutePlan struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RoutePlan) Reset() {
+	*x = RoutePlan{}
+	mi := &file_parser_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RoutePlan) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RoutePlan) ProtoMessage() {}
+
+func (x *RoutePlan) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RoutePlan.ProtoReflect.Descriptor instead.
+func (*RoutePlan) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{18}
+}
+
+type RouteStep struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dex           []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Swap          *Swap                  `protobuf:"bytes,2,opt,name=swap,proto3" json:"swap,omitempty"`
+	RoutePlan     *RoutePlan             `protobuf:"bytes,3,opt,name=route_plan,json=routePlan,proto3" json:"route_plan,omitempty"`
+	SwapEvent     *SwapEvent             `protobuf:"bytes,4,opt,name=swap_event,json=swapEvent,proto3" json:"swap_event,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RouteStep) Reset() {
+	*x = RouteStep{}
+	mi := &file_parser_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RouteStep) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RouteStep) ProtoMessage() {}
+
+func (x *RouteStep) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RouteStep.ProtoReflect.Descriptor instead.
+func (*RouteStep) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *RouteStep) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *RouteStep) GetSwap() *Swap {
+	if x != nil {
+		return x.Swap
+	}
+	return nil
+}
+
+func (x *RouteStep) GetRoutePlan() *RoutePlan {
+	if x != nil {
+		return x.RoutePlan
+	}
+	return nil
+}
+
+func (x *RouteStep) GetSwapEvent() *SwapEvent {
+	if x != nil {
+		return x.SwapEvent
+	}
+	return nil
+}
+
+type Route struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Router        []byte                 `protobuf:"bytes,1,opt,name=router,proto3" json:"router,omitempty"`
+	User          []byte                 `protobuf:"bytes,2,opt,name=user,proto3" json:"user,omitempty"`
+	RouteSteps    []*RouteStep           `protobuf:"bytes,3,rep,name=route_steps,json=routeSteps,proto3" json:"route_steps,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Route) Reset() {
+	*x = Route{}
+	mi := &file_parser_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Route) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Route) ProtoMessage() {}
+
+func (x *Route) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Route.ProtoReflect.Descriptor instead.
+func (*Route) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *Route) GetRouter() []byte {
+	if x != nil {
+		return x.Router
+	}
+	return nil
+}
+
+func (x *Route) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *Route) GetRouteSteps() []*RouteStep {
+	if x != nil {
+		return x.RouteSteps
+	}
+	return nil
+}
+
+type Transfer struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint          []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	From          []byte                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	To            []byte                 `protobuf:"bytes,4,opt,name=to,proto3" json:"to,omitempty"`
+	Amount        uint64                 `protobuf:"varint,5,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,6,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	UiAmount      string                 `protobuf:"bytes,7,opt,name=ui_amount,json=uiAmount,proto3" json:"ui_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_parser_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *Transfer) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *Transfer) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *Transfer) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *Transfer) GetTo() []byte {
+	if x != nil {
+		return x.To
+	}
+	return nil
+}
+
+func (x *Transfer) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Transfer) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Transfer) GetUiAmount() string {
+	if x != nil {
+		return x.UiAmount
+	}
+	return ""
+}
+
+type MintTo struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint          []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Account       []byte                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Amount        uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	UiAmount      string                 `protobuf:"bytes,6,opt,name=ui_amount,json=uiAmount,proto3" json:"ui_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MintTo) Reset() {
+	*x = MintTo{}
+	mi := &file_parser_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MintTo) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MintTo) ProtoMessage() {}
+
+func (x *MintTo) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MintTo.ProtoReflect.Descriptor instead.
+func (*MintTo) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *MintTo) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *MintTo) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MintTo) GetAccount() []byte {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *MintTo) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *MintTo) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *MintTo) GetUiAmount() string {
+	if x != nil {
+		return x.UiAmount
+	}
+	return ""
+}
+
+type Burn struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint          []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	Account       []byte                 `protobuf:"bytes,3,opt,name=account,proto3" json:"account,omitempty"`
+	Amount        uint64                 `protobuf:"varint,4,opt,name=amount,proto3" json:"amount,omitempty"`
+	Decimals      uint32                 `protobuf:"varint,5,opt,name=decimals,proto3" json:"decimals,omitempty"`
+	UiAmount      string                 `protobuf:"bytes,6,opt,name=ui_amount,json=uiAmount,proto3" json:"ui_amount,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Burn) Reset() {
+	*x = Burn{}
+	mi := &file_parser_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Burn) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Burn) ProtoMessage() {}
+
+func (x *Burn) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Burn.ProtoReflect.Descriptor instead.
+func (*Burn) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Burn) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *Burn) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *Burn) GetAccount() []byte {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Burn) GetAmount() uint64 {
+	if x != nil {
+		return x.Amount
+	}
+	return 0
+}
+
+func (x *Burn) GetDecimals() uint32 {
+	if x != nil {
+		return x.Decimals
+	}
+	return 0
+}
+
+func (x *Burn) GetUiAmount() string {
+	if x != nil {
+		return x.UiAmount
+	}
+	return ""
+}
+
+type Initialize struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Account       []byte                 `protobuf:"bytes,2,opt,name=account,proto3" json:"account,omitempty"`
+	Owner         []byte                 `protobuf:"bytes,3,opt,name=owner,proto3" json:"owner,omitempty"`
+	Mint          []byte                 `protobuf:"bytes,4,opt,name=mint,proto3" json:"mint,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Initialize) Reset() {
+	*x = Initialize{}
+	mi := &file_parser_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Initialize) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Initialize) ProtoMessage() {}
+
+func (x *Initialize) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Initialize.ProtoReflect.Descriptor instead.
+func (*Initialize) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Initialize) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *Initialize) GetAccount() []byte {
+	if x != nil {
+		return x.Account
+	}
+	return nil
+}
+
+func (x *Initialize) GetOwner() []byte {
+	if x != nil {
+		return x.Owner
+	}
+	return nil
+}
+
+func (x *Initialize) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+type MemeCreate struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Dex                    []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Mint                   []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	User                   []byte                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	BondingCurve           []byte                 `protobuf:"bytes,4,opt,name=bonding_curve,json=bondingCurve,proto3" json:"bonding_curve,omitempty"`
+	AssociatedBondingCurve []byte                 `protobuf:"bytes,5,opt,name=associated_bonding_curve,json=associatedBondingCurve,proto3" json:"associated_bonding_curve,omitempty"`
+	MintTo                 *MintTo                `protobuf:"bytes,6,opt,name=mint_to,json=mintTo,proto3" json:"mint_to,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MemeCreate) Reset() {
+	*x = MemeCreate{}
+	mi := &file_parser_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemeCreate) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemeCreate) ProtoMessage() {}
+
+func (x *MemeCreate) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemeCreate.ProtoReflect.Descriptor instead.
+func (*MemeCreate) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *MemeCreate) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *MemeCreate) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MemeCreate) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MemeCreate) GetBondingCurve() []byte {
+	if x != nil {
+		return x.BondingCurve
+	}
+	return nil
+}
+
+func (x *MemeCreate) GetAssociatedBondingCurve() []byte {
+	if x != nil {
+		return x.AssociatedBondingCurve
+	}
+	return nil
+}
+
+func (x *MemeCreate) GetMintTo() *MintTo {
+	if x != nil {
+		return x.MintTo
+	}
+	return nil
+}
+
+type MemeBuy struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Dex                    []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Mint                   []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	User                   []byte                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	BondingCurve           []byte                 `protobuf:"bytes,4,opt,name=bonding_curve,json=bondingCurve,proto3" json:"bonding_curve,omitempty"`
+	AssociatedBondingCurve []byte                 `protobuf:"bytes,5,opt,name=associated_bonding_curve,json=associatedBondingCurve,proto3" json:"associated_bonding_curve,omitempty"`
+	MintTransfer           *Transfer              `protobuf:"bytes,6,opt,name=mint_transfer,json=mintTransfer,proto3" json:"mint_transfer,omitempty"`
+	SolTransfer            *Transfer              `protobuf:"bytes,7,opt,name=sol_transfer,json=solTransfer,proto3" json:"sol_transfer,omitempty"`
+	FeeTransfer            *Transfer              `protobuf:"bytes,8,opt,name=fee_transfer,json=feeTransfer,proto3" json:"fee_transfer,omitempty"`
+	Amounts                *SwapAmounts           `protobuf:"bytes,9,opt,name=amounts,proto3" json:"amounts,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MemeBuy) Reset() {
+	*x = MemeBuy{}
+	mi := &file_parser_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemeBuy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemeBuy) ProtoMessage() {}
+
+func (x *MemeBuy) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemeBuy.ProtoReflect.Descriptor instead.
+func (*MemeBuy) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *MemeBuy) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetBondingCurve() []byte {
+	if x != nil {
+		return x.BondingCurve
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetAssociatedBondingCurve() []byte {
+	if x != nil {
+		return x.AssociatedBondingCurve
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetMintTransfer() *Transfer {
+	if x != nil {
+		return x.MintTransfer
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetSolTransfer() *Transfer {
+	if x != nil {
+		return x.SolTransfer
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetFeeTransfer() *Transfer {
+	if x != nil {
+		return x.FeeTransfer
+	}
+	return nil
+}
+
+func (x *MemeBuy) GetAmounts() *SwapAmounts {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type MemeSell struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	Dex                    []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Mint                   []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	User                   []byte                 `protobuf:"bytes,3,opt,name=user,proto3" json:"user,omitempty"`
+	BondingCurve           []byte                 `protobuf:"bytes,4,opt,name=bonding_curve,json=bondingCurve,proto3" json:"bonding_curve,omitempty"`
+	AssociatedBondingCurve []byte                 `protobuf:"bytes,5,opt,name=associated_bonding_curve,json=associatedBondingCurve,proto3" json:"associated_bonding_curve,omitempty"`
+	MintTransfer           *Transfer              `protobuf:"bytes,6,opt,name=mint_transfer,json=mintTransfer,proto3" json:"mint_transfer,omitempty"`
+	Amounts                *SwapAmounts           `protobuf:"bytes,7,opt,name=amounts,proto3" json:"amounts,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *MemeSell) Reset() {
+	*x = MemeSell{}
+	mi := &file_parser_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemeSell) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemeSell) ProtoMessage() {}
+
+func (x *MemeSell) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemeSell.ProtoReflect.Descriptor instead.
+func (*MemeSell) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *MemeSell) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *MemeSell) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MemeSell) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MemeSell) GetBondingCurve() []byte {
+	if x != nil {
+		return x.BondingCurve
+	}
+	return nil
+}
+
+func (x *MemeSell) GetAssociatedBondingCurve() []byte {
+	if x != nil {
+		return x.AssociatedBondingCurve
+	}
+	return nil
+}
+
+func (x *MemeSell) GetMintTransfer() *Transfer {
+	if x != nil {
+		return x.MintTransfer
+	}
+	return nil
+}
+
+func (x *MemeSell) GetAmounts() *SwapAmounts {
+	if x != nil {
+		return x.Amounts
+	}
+	return nil
+}
+
+type UnknownInstruction struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId      []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	ProgramName    string                 `protobuf:"bytes,2,opt,name=program_name,json=programName,proto3" json:"program_name,omitempty"`
+	Discriminator1 string                 `protobuf:"bytes,3,opt,name=discriminator1,proto3" json:"discriminator1,omitempty"`
+	Discriminator4 string                 `protobuf:"bytes,4,opt,name=discriminator4,proto3" json:"discriminator4,omitempty"`
+	Discriminator8 string                 `protobuf:"bytes,5,opt,name=discriminator8,proto3" json:"discriminator8,omitempty"`
+	DataLength     int64                  `protobuf:"varint,6,opt,name=data_length,json=dataLength,proto3" json:"data_length,omitempty"`
+	Accounts       [][]byte               `protobuf:"bytes,7,rep,name=accounts,proto3" json:"accounts,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *UnknownInstruction) Reset() {
+	*x = UnknownInstruction{}
+	mi := &file_parser_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UnknownInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UnknownInstruction) ProtoMessage() {}
+
+func (x *UnknownInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UnknownInstruction.ProtoReflect.Descriptor instead.
+func (*UnknownInstruction) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *UnknownInstruction) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *UnknownInstruction) GetProgramName() string {
+	if x != nil {
+		return x.ProgramName
+	}
+	return ""
+}
+
+func (x *UnknownInstruction) GetDiscriminator1() string {
+	if x != nil {
+		return x.Discriminator1
+	}
+	return ""
+}
+
+func (x *UnknownInstruction) GetDiscriminator4() string {
+	if x != nil {
+		return x.Discriminator4
+	}
+	return ""
+}
+
+func (x *UnknownInstruction) GetDiscriminator8() string {
+	if x != nil {
+		return x.Discriminator8
+	}
+	return ""
+}
+
+func (x *UnknownInstruction) GetDataLength() int64 {
+	if x != nil {
+		return x.DataLength
+	}
+	return 0
+}
+
+func (x *UnknownInstruction) GetAccounts() [][]byte {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+type Pool struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Dex           []byte                 `protobuf:"bytes,1,opt,name=dex,proto3" json:"dex,omitempty"`
+	Hash          []byte                 `protobuf:"bytes,2,opt,name=hash,proto3" json:"hash,omitempty"`
+	MintA         []byte                 `protobuf:"bytes,3,opt,name=mint_a,json=mintA,proto3" json:"mint_a,omitempty"`
+	MintB         []byte                 `protobuf:"bytes,4,opt,name=mint_b,json=mintB,proto3" json:"mint_b,omitempty"`
+	MintLp        []byte                 `protobuf:"bytes,5,opt,name=mint_lp,json=mintLp,proto3" json:"mint_lp,omitempty"`
+	VaultA        []byte                 `protobuf:"bytes,6,opt,name=vault_a,json=vaultA,proto3" json:"vault_a,omitempty"`
+	VaultB        []byte                 `protobuf:"bytes,7,opt,name=vault_b,json=vaultB,proto3" json:"vault_b,omitempty"`
+	ReserveA      uint64                 `protobuf:"varint,8,opt,name=reserve_a,json=reserveA,proto3" json:"reserve_a,omitempty"`
+	ReserveB      uint64                 `protobuf:"varint,9,opt,name=reserve_b,json=reserveB,proto3" json:"reserve_b,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Pool) Reset() {
+	*x = Pool{}
+	mi := &file_parser_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Pool) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Pool) ProtoMessage() {}
+
+func (x *Pool) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Pool.ProtoReflect.Descriptor instead.
+func (*Pool) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *Pool) GetDex() []byte {
+	if x != nil {
+		return x.Dex
+	}
+	return nil
+}
+
+func (x *Pool) GetHash() []byte {
+	if x != nil {
+		return x.Hash
+	}
+	return nil
+}
+
+func (x *Pool) GetMintA() []byte {
+	if x != nil {
+		return x.MintA
+	}
+	return nil
+}
+
+func (x *Pool) GetMintB() []byte {
+	if x != nil {
+		return x.MintB
+	}
+	return nil
+}
+
+func (x *Pool) GetMintLp() []byte {
+	if x != nil {
+		return x.MintLp
+	}
+	return nil
+}
+
+func (x *Pool) GetVaultA() []byte {
+	if x != nil {
+		return x.VaultA
+	}
+	return nil
+}
+
+func (x *Pool) GetVaultB() []byte {
+	if x != nil {
+		return x.VaultB
+	}
+	return nil
+}
+
+func (x *Pool) GetReserveA() uint64 {
+	if x != nil {
+		return x.ReserveA
+	}
+	return 0
+}
+
+func (x *Pool) GetReserveB() uint64 {
+	if x != nil {
+		return x.ReserveB
+	}
+	return 0
+}
+
+type MemeCreateEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Symbol        string                 `protobuf:"bytes,3,opt,name=symbol,proto3" json:"symbol,omitempty"`
+	Uri           string                 `protobuf:"bytes,4,opt,name=uri,proto3" json:"uri,omitempty"`
+	Mint          []byte                 `protobuf:"bytes,5,opt,name=mint,proto3" json:"mint,omitempty"`
+	BondingCurve  []byte                 `protobuf:"bytes,6,opt,name=bonding_curve,json=bondingCurve,proto3" json:"bonding_curve,omitempty"`
+	User          []byte                 `protobuf:"bytes,7,opt,name=user,proto3" json:"user,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *MemeCreateEvent) Reset() {
+	*x = MemeCreateEvent{}
+	mi := &file_parser_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemeCreateEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemeCreateEvent) ProtoMessage() {}
+
+func (x *MemeCreateEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemeCreateEvent.ProtoReflect.Descriptor instead.
+func (*MemeCreateEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *MemeCreateEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *MemeCreateEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *MemeCreateEvent) GetSymbol() string {
+	if x != nil {
+		return x.Symbol
+	}
+	return ""
+}
+
+func (x *MemeCreateEvent) GetUri() string {
+	if x != nil {
+		return x.Uri
+	}
+	return ""
+}
+
+func (x *MemeCreateEvent) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MemeCreateEvent) GetBondingCurve() []byte {
+	if x != nil {
+		return x.BondingCurve
+	}
+	return nil
+}
+
+func (x *MemeCreateEvent) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+type MemeBuyEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId            []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint                 []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	SolAmount            uint64                 `protobuf:"varint,3,opt,name=sol_amount,json=solAmount,proto3" json:"sol_amount,omitempty"`
+	TokenAmount          uint64                 `protobuf:"varint,4,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"`
+	IsBuy                bool                   `protobuf:"varint,5,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	User                 []byte                 `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Timestamp            int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	VirtualSolReserves   uint64                 `protobuf:"varint,8,opt,name=virtual_sol_reserves,json=virtualSolReserves,proto3" json:"virtual_sol_reserves,omitempty"`
+	VirtualTokenReserves uint64                 `protobuf:"varint,9,opt,name=virtual_token_reserves,json=virtualTokenReserves,proto3" json:"virtual_token_reserves,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MemeBuyEvent) Reset() {
+	*x = MemeBuyEvent{}
+	mi := &file_parser_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemeBuyEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemeBuyEvent) ProtoMessage() {}
+
+func (x *MemeBuyEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemeBuyEvent.ProtoReflect.Descriptor instead.
+func (*MemeBuyEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *MemeBuyEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *MemeBuyEvent) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MemeBuyEvent) GetSolAmount() uint64 {
+	if x != nil {
+		return x.SolAmount
+	}
+	return 0
+}
+
+func (x *MemeBuyEvent) GetTokenAmount() uint64 {
+	if x != nil {
+		return x.TokenAmount
+	}
+	return 0
+}
+
+func (x *MemeBuyEvent) GetIsBuy() bool {
+	if x != nil {
+		return x.IsBuy
+	}
+	return false
+}
+
+func (x *MemeBuyEvent) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MemeBuyEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *MemeBuyEvent) GetVirtualSolReserves() uint64 {
+	if x != nil {
+		return x.VirtualSolReserves
+	}
+	return 0
+}
+
+func (x *MemeBuyEvent) GetVirtualTokenReserves() uint64 {
+	if x != nil {
+		return x.VirtualTokenReserves
+	}
+	return 0
+}
+
+type MemeSellEvent struct {
+	state                protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId            []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Mint                 []byte                 `protobuf:"bytes,2,opt,name=mint,proto3" json:"mint,omitempty"`
+	SolAmount            uint64                 `protobuf:"varint,3,opt,name=sol_amount,json=solAmount,proto3" json:"sol_amount,omitempty"`
+	TokenAmount          uint64                 `protobuf:"varint,4,opt,name=token_amount,json=tokenAmount,proto3" json:"token_amount,omitempty"`
+	IsBuy                bool                   `protobuf:"varint,5,opt,name=is_buy,json=isBuy,proto3" json:"is_buy,omitempty"`
+	User                 []byte                 `protobuf:"bytes,6,opt,name=user,proto3" json:"user,omitempty"`
+	Timestamp            int64                  `protobuf:"varint,7,opt,name=timestamp,proto3" json:"timestamp,omitempty"`
+	VirtualSolReserves   uint64                 `protobuf:"varint,8,opt,name=virtual_sol_reserves,json=virtualSolReserves,proto3" json:"virtual_sol_reserves,omitempty"`
+	VirtualTokenReserves uint64                 `protobuf:"varint,9,opt,name=virtual_token_reserves,json=virtualTokenReserves,proto3" json:"virtual_token_reserves,omitempty"`
+	unknownFields        protoimpl.UnknownFields
+	sizeCache            protoimpl.SizeCache
+}
+
+func (x *MemeSellEvent) Reset() {
+	*x = MemeSellEvent{}
+	mi := &file_parser_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *MemeSellEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*MemeSellEvent) ProtoMessage() {}
+
+func (x *MemeSellEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use MemeSellEvent.ProtoReflect.Descriptor instead.
+func (*MemeSellEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *MemeSellEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *MemeSellEvent) GetMint() []byte {
+	if x != nil {
+		return x.Mint
+	}
+	return nil
+}
+
+func (x *MemeSellEvent) GetSolAmount() uint64 {
+	if x != nil {
+		return x.SolAmount
+	}
+	return 0
+}
+
+func (x *MemeSellEvent) GetTokenAmount() uint64 {
+	if x != nil {
+		return x.TokenAmount
+	}
+	return 0
+}
+
+func (x *MemeSellEvent) GetIsBuy() bool {
+	if x != nil {
+		return x.IsBuy
+	}
+	return false
+}
+
+func (x *MemeSellEvent) GetUser() []byte {
+	if x != nil {
+		return x.User
+	}
+	return nil
+}
+
+func (x *MemeSellEvent) GetTimestamp() int64 {
+	if x != nil {
+		return x.Timestamp
+	}
+	return 0
+}
+
+func (x *MemeSellEvent) GetVirtualSolReserves() uint64 {
+	if x != nil {
+		return x.VirtualSolReserves
+	}
+	return 0
+}
+
+func (x *MemeSellEvent) GetVirtualTokenReserves() uint64 {
+	if x != nil {
+		return x.VirtualTokenReserves
+	}
+	return 0
+}
+
+type SwapEvent struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId      []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Amm            []byte                 `protobuf:"bytes,2,opt,name=amm,proto3" json:"amm,omitempty"`
+	InputMint      []byte                 `protobuf:"bytes,3,opt,name=input_mint,json=inputMint,proto3" json:"input_mint,omitempty"`
+	InputAmount    uint64                 `protobuf:"varint,4,opt,name=input_amount,json=inputAmount,proto3" json:"input_amount,omitempty"`
+	InputDecimals  uint32                 `protobuf:"varint,5,opt,name=input_decimals,json=inputDecimals,proto3" json:"input_decimals,omitempty"`
+	InputUiAmount  string                 `protobuf:"bytes,6,opt,name=input_ui_amount,json=inputUiAmount,proto3" json:"input_ui_amount,omitempty"`
+	OutputMint     []byte                 `protobuf:"bytes,7,opt,name=output_mint,json=outputMint,proto3" json:"output_mint,omitempty"`
+	OutputAmount   uint64                 `protobuf:"varint,8,opt,name=output_amount,json=outputAmount,proto3" json:"output_amount,omitempty"`
+	OutputDecimals uint32                 `protobuf:"varint,9,opt,name=output_decimals,json=outputDecimals,proto3" json:"output_decimals,omitempty"`
+	OutputUiAmount string                 `protobuf:"bytes,10,opt,name=output_ui_amount,json=outputUiAmount,proto3" json:"output_ui_amount,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *SwapEvent) Reset() {
+	*x = SwapEvent{}
+	mi := &file_parser_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *SwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*SwapEvent) ProtoMessage() {}
+
+func (x *SwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use SwapEvent.ProtoReflect.Descriptor instead.
+func (*SwapEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *SwapEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *SwapEvent) GetAmm() []byte {
+	if x != nil {
+		return x.Amm
+	}
+	return nil
+}
+
+func (x *SwapEvent) GetInputMint() []byte {
+	if x != nil {
+		return x.InputMint
+	}
+	return nil
+}
+
+func (x *SwapEvent) GetInputAmount() uint64 {
+	if x != nil {
+		return x.InputAmount
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetInputDecimals() uint32 {
+	if x != nil {
+		return x.InputDecimals
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetInputUiAmount() string {
+	if x != nil {
+		return x.InputUiAmount
+	}
+	return ""
+}
+
+func (x *SwapEvent) GetOutputMint() []byte {
+	if x != nil {
+		return x.OutputMint
+	}
+	return nil
+}
+
+func (x *SwapEvent) GetOutputAmount() uint64 {
+	if x != nil {
+		return x.OutputAmount
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetOutputDecimals() uint32 {
+	if x != nil {
+		return x.OutputDecimals
+	}
+	return 0
+}
+
+func (x *SwapEvent) GetOutputUiAmount() string {
+	if x != nil {
+		return x.OutputUiAmount
+	}
+	return ""
+}
+
+type DlmmSwapEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	LbPair        []byte                 `protobuf:"bytes,2,opt,name=lb_pair,json=lbPair,proto3" json:"lb_pair,omitempty"`
+	From          []byte                 `protobuf:"bytes,3,opt,name=from,proto3" json:"from,omitempty"`
+	StartBinId    int32                  `protobuf:"zigzag32,4,opt,name=start_bin_id,json=startBinId,proto3" json:"start_bin_id,omitempty"`
+	EndBinId      int32                  `protobuf:"zigzag32,5,opt,name=end_bin_id,json=endBinId,proto3" json:"end_bin_id,omitempty"`
+	AmountIn      uint64                 `protobuf:"varint,6,opt,name=amount_in,json=amountIn,proto3" json:"amount_in,omitempty"`
+	AmountOut     uint64                 `protobuf:"varint,7,opt,name=amount_out,json=amountOut,proto3" json:"amount_out,omitempty"`
+	SwapForY      bool                   `protobuf:"varint,8,opt,name=swap_for_y,json=swapForY,proto3" json:"swap_for_y,omitempty"`
+	Fee           uint64                 `protobuf:"varint,9,opt,name=fee,proto3" json:"fee,omitempty"`
+	ProtocolFee   uint64                 `protobuf:"varint,10,opt,name=protocol_fee,json=protocolFee,proto3" json:"protocol_fee,omitempty"`
+	FeeBps        string                 `protobuf:"bytes,11,opt,name=fee_bps,json=feeBps,proto3" json:"fee_bps,omitempty"`
+	HostFee       uint64                 `protobuf:"varint,12,opt,name=host_fee,json=hostFee,proto3" json:"host_fee,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DlmmSwapEvent) Reset() {
+	*x = DlmmSwapEvent{}
+	mi := &file_parser_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DlmmSwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DlmmSwapEvent) ProtoMessage() {}
+
+func (x *DlmmSwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DlmmSwapEvent.ProtoReflect.Descriptor instead.
+func (*DlmmSwapEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *DlmmSwapEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *DlmmSwapEvent) GetLbPair() []byte {
+	if x != nil {
+		return x.LbPair
+	}
+	return nil
+}
+
+func (x *DlmmSwapEvent) GetFrom() []byte {
+	if x != nil {
+		return x.From
+	}
+	return nil
+}
+
+func (x *DlmmSwapEvent) GetStartBinId() int32 {
+	if x != nil {
+		return x.StartBinId
+	}
+	return 0
+}
+
+func (x *DlmmSwapEvent) GetEndBinId() int32 {
+	if x != nil {
+		return x.EndBinId
+	}
+	return 0
+}
+
+func (x *DlmmSwapEvent) GetAmountIn() uint64 {
+	if x != nil {
+		return x.AmountIn
+	}
+	return 0
+}
+
+func (x *DlmmSwapEvent) GetAmountOut() uint64 {
+	if x != nil {
+		return x.AmountOut
+	}
+	return 0
+}
+
+func (x *DlmmSwapEvent) GetSwapForY() bool {
+	if x != nil {
+		return x.SwapForY
+	}
+	return false
+}
+
+func (x *DlmmSwapEvent) GetFee() uint64 {
+	if x != nil {
+		return x.Fee
+	}
+	return 0
+}
+
+func (x *DlmmSwapEvent) GetProtocolFee() uint64 {
+	if x != nil {
+		return x.ProtocolFee
+	}
+	return 0
+}
+
+func (x *DlmmSwapEvent) GetFeeBps() string {
+	if x != nil {
+		return x.FeeBps
+	}
+	return ""
+}
+
+func (x *DlmmSwapEvent) GetHostFee() uint64 {
+	if x != nil {
+		return x.HostFee
+	}
+	return 0
+}
+
+type ClmmSwapEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	PoolState     []byte                 `protobuf:"bytes,2,opt,name=pool_state,json=poolState,proto3" json:"pool_state,omitempty"`
+	Sender        []byte                 `protobuf:"bytes,3,opt,name=sender,proto3" json:"sender,omitempty"`
+	TokenAccount0 []byte                 `protobuf:"bytes,4,opt,name=token_account0,json=tokenAccount0,proto3" json:"token_account0,omitempty"`
+	TokenAccount1 []byte                 `protobuf:"bytes,5,opt,name=token_account1,json=tokenAccount1,proto3" json:"token_account1,omitempty"`
+	Amount0       uint64                 `protobuf:"varint,6,opt,name=amount0,proto3" json:"amount0,omitempty"`
+	TransferFee0  uint64                 `protobuf:"varint,7,opt,name=transfer_fee0,json=transferFee0,proto3" json:"transfer_fee0,omitempty"`
+	Amount1       uint64                 `protobuf:"varint,8,opt,name=amount1,proto3" json:"amount1,omitempty"`
+	TransferFee1  uint64                 `protobuf:"varint,9,opt,name=transfer_fee1,json=transferFee1,proto3" json:"transfer_fee1,omitempty"`
+	ZeroForOne    bool                   `protobuf:"varint,10,opt,name=zero_for_one,json=zeroForOne,proto3" json:"zero_for_one,omitempty"`
+	SqrtPriceX64  string                 `protobuf:"bytes,11,opt,name=sqrt_price_x64,json=sqrtPriceX64,proto3" json:"sqrt_price_x64,omitempty"`
+	Liquidity     string                 `protobuf:"bytes,12,opt,name=liquidity,proto3" json:"liquidity,omitempty"`
+	Tick          int32                  `protobuf:"zigzag32,13,opt,name=tick,proto3" json:"tick,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ClmmSwapEvent) Reset() {
+	*x = ClmmSwapEvent{}
+	mi := &file_parser_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ClmmSwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ClmmSwapEvent) ProtoMessage() {}
+
+func (x *ClmmSwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ClmmSwapEvent.ProtoReflect.Descriptor instead.
+func (*ClmmSwapEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *ClmmSwapEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *ClmmSwapEvent) GetPoolState() []byte {
+	if x != nil {
+		return x.PoolState
+	}
+	return nil
+}
+
+func (x *ClmmSwapEvent) GetSender() []byte {
+	if x != nil {
+		return x.Sender
+	}
+	return nil
+}
+
+func (x *ClmmSwapEvent) GetTokenAccount0() []byte {
+	if x != nil {
+		return x.TokenAccount0
+	}
+	return nil
+}
+
+func (x *ClmmSwapEvent) GetTokenAccount1() []byte {
+	if x != nil {
+		return x.TokenAccount1
+	}
+	return nil
+}
+
+func (x *ClmmSwapEvent) GetAmount0() uint64 {
+	if x != nil {
+		return x.Amount0
+	}
+	return 0
+}
+
+func (x *ClmmSwapEvent) GetTransferFee0() uint64 {
+	if x != nil {
+		return x.TransferFee0
+	}
+	return 0
+}
+
+func (x *ClmmSwapEvent) GetAmount1() uint64 {
+	if x != nil {
+		return x.Amount1
+	}
+	return 0
+}
+
+func (x *ClmmSwapEvent) GetTransferFee1() uint64 {
+	if x != nil {
+		return x.TransferFee1
+	}
+	return 0
+}
+
+func (x *ClmmSwapEvent) GetZeroForOne() bool {
+	if x != nil {
+		return x.ZeroForOne
+	}
+	return false
+}
+
+func (x *ClmmSwapEvent) GetSqrtPriceX64() string {
+	if x != nil {
+		return x.SqrtPriceX64
+	}
+	return ""
+}
+
+func (x *ClmmSwapEvent) GetLiquidity() string {
+	if x != nil {
+		return x.Liquidity
+	}
+	return ""
+}
+
+func (x *ClmmSwapEvent) GetTick() int32 {
+	if x != nil {
+		return x.Tick
+	}
+	return 0
+}
+
+type CpSwapEvent struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId         []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	PoolId            []byte                 `protobuf:"bytes,2,opt,name=pool_id,json=poolId,proto3" json:"pool_id,omitempty"`
+	InputVaultBefore  uint64                 `protobuf:"varint,3,opt,name=input_vault_before,json=inputVaultBefore,proto3" json:"input_vault_before,omitempty"`
+	OutputVaultBefore uint64                 `protobuf:"varint,4,opt,name=output_vault_before,json=outputVaultBefore,proto3" json:"output_vault_before,omitempty"`
+	InputAmount       uint64                 `protobuf:"varint,5,opt,name=input_amount,json=inputAmount,proto3" json:"input_amount,omitempty"`
+	OutputAmount      uint64                 `protobuf:"varint,6,opt,name=output_amount,json=outputAmount,proto3" json:"output_amount,omitempty"`
+	InputTransferFee  uint64                 `protobuf:"varint,7,opt,name=input_transfer_fee,json=inputTransferFee,proto3" json:"input_transfer_fee,omitempty"`
+	OutputTransferFee uint64                 `protobuf:"varint,8,opt,name=output_transfer_fee,json=outputTransferFee,proto3" json:"output_transfer_fee,omitempty"`
+	BaseInput         bool                   `protobuf:"varint,9,opt,name=base_input,json=baseInput,proto3" json:"base_input,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *CpSwapEvent) Reset() {
+	*x = CpSwapEvent{}
+	mi := &file_parser_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CpSwapEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CpSwapEvent) ProtoMessage() {}
+
+func (x *CpSwapEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CpSwapEvent.ProtoReflect.Descriptor instead.
+func (*CpSwapEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *CpSwapEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *CpSwapEvent) GetPoolId() []byte {
+	if x != nil {
+		return x.PoolId
+	}
+	return nil
+}
+
+func (x *CpSwapEvent) GetInputVaultBefore() uint64 {
+	if x != nil {
+		return x.InputVaultBefore
+	}
+	return 0
+}
+
+func (x *CpSwapEvent) GetOutputVaultBefore() uint64 {
+	if x != nil {
+		return x.OutputVaultBefore
+	}
+	return 0
+}
+
+func (x *CpSwapEvent) GetInputAmount() uint64 {
+	if x != nil {
+		return x.InputAmount
+	}
+	return 0
+}
+
+func (x *CpSwapEvent) GetOutputAmount() uint64 {
+	if x != nil {
+		return x.OutputAmount
+	}
+	return 0
+}
+
+func (x *CpSwapEvent) GetInputTransferFee() uint64 {
+	if x != nil {
+		return x.InputTransferFee
+	}
+	return 0
+}
+
+func (x *CpSwapEvent) GetOutputTransferFee() uint64 {
+	if x != nil {
+		return x.OutputTransferFee
+	}
+	return 0
+}
+
+func (x *CpSwapEvent) GetBaseInput() bool {
+	if x != nil {
+		return x.BaseInput
+	}
+	return false
+}
+
+// DecodedInstruction is an instruction decoded with an idl, args is the json of the arguments
+type DecodedInstruction struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Args          []byte                 `protobuf:"bytes,3,opt,name=args,proto3" json:"args,omitempty"`
+	Accounts      map[string][]byte      `protobuf:"bytes,4,rep,name=accounts,proto3" json:"accounts,omitempty" protobuf_key:"bytes,1,opt,name=key" protobuf_val:"bytes,2,opt,name=value"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodedInstruction) Reset() {
+	*x = DecodedInstruction{}
+	mi := &file_parser_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodedInstruction) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedInstruction) ProtoMessage() {}
+
+func (x *DecodedInstruction) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedInstruction.ProtoReflect.Descriptor instead.
+func (*DecodedInstruction) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *DecodedInstruction) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *DecodedInstruction) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecodedInstruction) GetArgs() []byte {
+	if x != nil {
+		return x.Args
+	}
+	return nil
+}
+
+func (x *DecodedInstruction) GetAccounts() map[string][]byte {
+	if x != nil {
+		return x.Accounts
+	}
+	return nil
+}
+
+// DecodedEvent is an anchor event decoded with an idl, fields is the json of the fields
+type DecodedEvent struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProgramId     []byte                 `protobuf:"bytes,1,opt,name=program_id,json=programId,proto3" json:"program_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Fields        []byte                 `protobuf:"bytes,3,opt,name=fields,proto3" json:"fields,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DecodedEvent) Reset() {
+	*x = DecodedEvent{}
+	mi := &file_parser_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DecodedEvent) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DecodedEvent) ProtoMessage() {}
+
+func (x *DecodedEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_parser_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DecodedEvent.ProtoReflect.Descriptor instead.
+func (*DecodedEvent) Descriptor() ([]byte, []int) {
+	return file_parser_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *DecodedEvent) GetProgramId() []byte {
+	if x != nil {
+		return x.ProgramId
+	}
+	return nil
+}
+
+func (x *DecodedEvent) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *DecodedEvent) GetFields() []byte {
+	if x != nil {
+		return x.Fields
+	}
+	return nil
+}
+
+var File_parser_proto protoreflect.FileDescriptor
+
+const file_parser_proto_rawDesc = "" +
+	"\n" +
+	"\fparser.proto\x12\x10solana_parser.v1\"\xc6\x01\n" +
+	"\x05Block\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x04R\x04time\x12\x12\n" +
+	"\x04slot\x18\x03 \x01(\x04R\x04slot\x12A\n" +
+	"\ftransactions\x18\x04 \x03(\v2\x1d.solana_parser.v1.TransactionR\ftransactions\x12>\n" +
+	"\vdiagnostics\x18\x05 \x03(\v2\x1c.solana_parser.v1.DiagnosticR\vdiagnostics\"\xdd\x02\n" +
+	"\vTransaction\x12\x12\n" +
+	"\x04hash\x18\x01 \x01(\fR\x04hash\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x04R\x04time\x12\x12\n" +
+	"\x04slot\x18\x03 \x01(\x04R\x04slot\x12A\n" +
+	"\finstructions\x18\x04 \x03(\v2\x1d.solana_parser.v1.InstructionR\finstructions\x12*\n" +
+	"\x04meta\x18\x05 \x01(\v2\x16.solana_parser.v1.MetaR\x04meta\x12\x10\n" +
+	"\x03seq\x18\x06 \x01(\x03R\x03seq\x12\x16\n" +
+	"\x06failed\x18\a \x01(\bR\x06failed\x12>\n" +
+	"\vdiagnostics\x18\b \x03(\v2\x1c.solana_parser.v1.DiagnosticR\vdiagnostics\x129\n" +
+	"\aactions\x18\t \x03(\v2\x1f.solana_parser.v1.PrimaryActionR\aactions\"j\n" +
+	"\vAccountMeta\x12\x1d\n" +
+	"\n" +
+	"public_key\x18\x01 \x01(\fR\tpublicKey\x12\x1f\n" +
+	"\vis_writable\x18\x02 \x01(\bR\n" +
+	"isWritable\x12\x1b\n" +
+	"\tis_signer\x18\x03 \x01(\bR\bisSigner\"\x88\x04\n" +
+	"\vInstruction\x12\x10\n" +
+	"\x03seq\x18\x01 \x01(\x03R\x03seq\x12\x12\n" +
+	"\x04path\x18\x02 \x03(\x03R\x04path\x12\x14\n" +
+	"\x05depth\x18\x03 \x01(\x03R\x05depth\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x04 \x01(\fR\tprogramId\x129\n" +
+	"\baccounts\x18\x05 \x03(\v2\x1d.solana_parser.v1.AccountMetaR\baccounts\x12\x12\n" +
+	"\x04data\x18\x06 \x01(\fR\x04data\x12-\n" +
+	"\x12parsed_instruction\x18\a \x01(\fR\x11parsedInstruction\x12/\n" +
+	"\x06events\x18\b \x03(\v2\x17.solana_parser.v1.EventR\x06events\x123\n" +
+	"\breceipts\x18\t \x03(\v2\x17.solana_parser.v1.EventR\breceipts\x129\n" +
+	"\bchildren\x18\n" +
+	" \x03(\v2\x1d.solana_parser.v1.InstructionR\bchildren\x12\x12\n" +
+	"\x04logs\x18\v \x03(\tR\x04logs\x12#\n" +
+	"\rcompute_units\x18\f \x01(\x04R\fcomputeUnits\x12\x18\n" +
+	"\asuccess\x18\r \x01(\bR\asuccess\x12\x16\n" +
+	"\x06failed\x18\x0e \x01(\bR\x06failed\x12\x14\n" +
+	"\x05error\x18\x0f \x01(\tR\x05error\"q\n" +
+	"\fTokenAccount\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\fR\aaccount\x12\x14\n" +
+	"\x05owner\x18\x02 \x01(\fR\x05owner\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x03 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04mint\x18\x04 \x01(\fR\x04mint\"=\n" +
+	"\vMintAccount\x12\x12\n" +
+	"\x04mint\x18\x01 \x01(\fR\x04mint\x12\x1a\n" +
+	"\bdecimals\x18\x02 \x01(\rR\bdecimals\";\n" +
+	"\aBalance\x12\x18\n" +
+	"\aaccount\x18\x01 \x01(\fR\aaccount\x12\x16\n" +
+	"\x06amount\x18\x02 \x01(\tR\x06amount\"w\n" +
+	"\rBalanceChange\x12\x14\n" +
+	"\x05owner\x18\x01 \x01(\fR\x05owner\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x10\n" +
+	"\x03pre\x18\x03 \x01(\tR\x03pre\x12\x12\n" +
+	"\x04post\x18\x04 \x01(\tR\x04post\x12\x16\n" +
+	"\x06change\x18\x05 \x01(\tR\x06change\"\x9a\x05\n" +
+	"\x04Meta\x129\n" +
+	"\baccounts\x18\x01 \x03(\v2\x1d.solana_parser.v1.AccountMetaR\baccounts\x12E\n" +
+	"\x0etoken_accounts\x18\x02 \x03(\v2\x1e.solana_parser.v1.TokenAccountR\rtokenAccounts\x12B\n" +
+	"\rmint_accounts\x18\x03 \x03(\v2\x1d.solana_parser.v1.MintAccountR\fmintAccounts\x12E\n" +
+	"\x11token_pre_balance\x18\x04 \x03(\v2\x19.solana_parser.v1.BalanceR\x0ftokenPreBalance\x12G\n" +
+	"\x12token_post_balance\x18\x05 \x03(\v2\x19.solana_parser.v1.BalanceR\x10tokenPostBalance\x12A\n" +
+	"\x0fsol_pre_balance\x18\x06 \x03(\v2\x19.solana_parser.v1.BalanceR\rsolPreBalance\x12C\n" +
+	"\x10sol_post_balance\x18\a \x03(\v2\x19.solana_parser.v1.BalanceR\x0esolPostBalance\x12E\n" +
+	"\x11sol_balance_delta\x18\b \x03(\v2\x19.solana_parser.v1.BalanceR\x0fsolBalanceDelta\x12H\n" +
+	"\x0fbalance_changes\x18\t \x03(\v2\x1f.solana_parser.v1.BalanceChangeR\x0ebalanceChanges\x12#\n" +
+	"\rerror_message\x18\n" +
+	" \x01(\fR\ferrorMessage\"\xc6\x01\n" +
+	"\n" +
+	"Diagnostic\x12\x1c\n" +
+	"\tsignature\x18\x01 \x01(\fR\tsignature\x12\x12\n" +
+	"\x04path\x18\x02 \x03(\x03R\x04path\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\fR\aaccount\x12\x18\n" +
+	"\aprogram\x18\x04 \x01(\fR\aprogram\x12$\n" +
+	"\rdiscriminator\x18\x05 \x01(\tR\rdiscriminator\x12\x12\n" +
+	"\x04kind\x18\x06 \x01(\tR\x04kind\x12\x18\n" +
+	"\amessage\x18\a \x01(\tR\amessage\"\xb2\x01\n" +
+	"\rPrimaryAction\x12\x12\n" +
+	"\x04path\x18\x01 \x03(\x03R\x04path\x12\x18\n" +
+	"\aprogram\x18\x02 \x01(\fR\aprogram\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12\x12\n" +
+	"\x04type\x18\x04 \x01(\tR\x04type\x12\x1a\n" +
+	"\bpriority\x18\x05 \x01(\x03R\bpriority\x12/\n" +
+	"\x06action\x18\x06 \x01(\v2\x17.solana_parser.v1.EventR\x06action\"\xb2\f\n" +
+	"\x05Event\x12?\n" +
+	"\vcreate_pool\x18\x01 \x01(\v2\x1c.solana_parser.v1.CreatePoolH\x00R\n" +
+	"createPool\x12E\n" +
+	"\radd_liquidity\x18\x02 \x01(\v2\x1e.solana_parser.v1.AddLiquidityH\x00R\faddLiquidity\x12N\n" +
+	"\x10remove_liquidity\x18\x03 \x01(\v2!.solana_parser.v1.RemoveLiquidityH\x00R\x0fremoveLiquidity\x12,\n" +
+	"\x04swap\x18\x04 \x01(\v2\x16.solana_parser.v1.SwapH\x00R\x04swap\x12/\n" +
+	"\x05route\x18\x05 \x01(\v2\x17.solana_parser.v1.RouteH\x00R\x05route\x128\n" +
+	"\btransfer\x18\x06 \x01(\v2\x1a.solana_parser.v1.TransferH\x00R\btransfer\x123\n" +
+	"\amint_to\x18\a \x01(\v2\x18.solana_parser.v1.MintToH\x00R\x06mintTo\x12,\n" +
+	"\x04burn\x18\b \x01(\v2\x16.solana_parser.v1.BurnH\x00R\x04burn\x12>\n" +
+	"\n" +
+	"initialize\x18\t \x01(\v2\x1c.solana_parser.v1.InitializeH\x00R\n" +
+	"initialize\x12?\n" +
+	"\vmeme_create\x18\n" +
+	" \x01(\v2\x1c.solana_parser.v1.MemeCreateH\x00R\n" +
+	"memeCreate\x126\n" +
+	"\bmeme_buy\x18\v \x01(\v2\x19.solana_parser.v1.MemeBuyH\x00R\amemeBuy\x129\n" +
+	"\tmeme_sell\x18\f \x01(\v2\x1a.solana_parser.v1.MemeSellH\x00R\bmemeSell\x12W\n" +
+	"\x13unknown_instruction\x18\r \x01(\v2$.solana_parser.v1.UnknownInstructionH\x00R\x12unknownInstruction\x12,\n" +
+	"\x04pool\x18\x0e \x01(\v2\x16.solana_parser.v1.PoolH\x00R\x04pool\x12O\n" +
+	"\x11meme_create_event\x18\x0f \x01(\v2!.solana_parser.v1.MemeCreateEventH\x00R\x0fmemeCreateEvent\x12F\n" +
+	"\x0ememe_buy_event\x18\x10 \x01(\v2\x1e.solana_parser.v1.MemeBuyEventH\x00R\fmemeBuyEvent\x12I\n" +
+	"\x0fmeme_sell_event\x18\x11 \x01(\v2\x1f.solana_parser.v1.MemeSellEventH\x00R\rmemeSellEvent\x12<\n" +
+	"\n" +
+	"swap_event\x18\x12 \x01(\v2\x1b.solana_parser.v1.SwapEventH\x00R\tswapEvent\x12I\n" +
+	"\x0fdlmm_swap_event\x18\x13 \x01(\v2\x1f.solana_parser.v1.DlmmSwapEventH\x00R\rdlmmSwapEvent\x12I\n" +
+	"\x0fclmm_swap_event\x18\x14 \x01(\v2\x1f.solana_parser.v1.ClmmSwapEventH\x00R\rclmmSwapEvent\x12C\n" +
+	"\rcp_swap_event\x18\x15 \x01(\v2\x1d.solana_parser.v1.CpSwapEventH\x00R\vcpSwapEvent\x12W\n" +
+	"\x13decoded_instruction\x18\x16 \x01(\v2$.solana_parser.v1.DecodedInstructionH\x00R\x12decodedInstruction\x12E\n" +
+	"\rdecoded_event\x18\x17 \x01(\v2\x1e.solana_parser.v1.DecodedEventH\x00R\fdecodedEvent\x124\n" +
+	"\x05other\x18d \x01(\v2\x1c.solana_parser.v1.OtherEventH\x00R\x05otherB\a\n" +
+	"\x05event\"4\n" +
+	"\n" +
+	"OtherEvent\x12\x12\n" +
+	"\x04kind\x18\x01 \x01(\tR\x04kind\x12\x12\n" +
+	"\x04json\x18\x02 \x01(\fR\x04json\"\xe0\x01\n" +
+	"\n" +
+	"CreatePool\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\fR\x04pool\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\fR\x04user\x12\x17\n" +
+	"\atoken_a\x18\x04 \x01(\fR\x06tokenA\x12\x17\n" +
+	"\atoken_b\x18\x05 \x01(\fR\x06tokenB\x12\x19\n" +
+	"\btoken_lp\x18\x06 \x01(\fR\atokenLp\x12\x17\n" +
+	"\avault_a\x18\a \x01(\fR\x06vaultA\x12\x17\n" +
+	"\avault_b\x18\b \x01(\fR\x06vaultB\x12\x19\n" +
+	"\bvault_lp\x18\t \x01(\fR\avaultLp\"\x92\x02\n" +
+	"\fAddLiquidity\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\fR\x04pool\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\fR\x04user\x12D\n" +
+	"\x10token_a_transfer\x18\x04 \x01(\v2\x1a.solana_parser.v1.TransferR\x0etokenATransfer\x12D\n" +
+	"\x10token_b_transfer\x18\x05 \x01(\v2\x1a.solana_parser.v1.TransferR\x0etokenBTransfer\x12<\n" +
+	"\rtoken_lp_mint\x18\x06 \x01(\v2\x18.solana_parser.v1.MintToR\vtokenLpMint\"\x93\x02\n" +
+	"\x0fRemoveLiquidity\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\fR\x04pool\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\fR\x04user\x12D\n" +
+	"\x10token_a_transfer\x18\x04 \x01(\v2\x1a.solana_parser.v1.TransferR\x0etokenATransfer\x12D\n" +
+	"\x10token_b_transfer\x18\x05 \x01(\v2\x1a.solana_parser.v1.TransferR\x0etokenBTransfer\x12:\n" +
+	"\rtoken_lp_burn\x18\x06 \x01(\v2\x16.solana_parser.v1.BurnR\vtokenLpBurn\"\xab\x01\n" +
+	"\vSwapAmounts\x12\x17\n" +
+	"\amint_in\x18\x01 \x01(\fR\x06mintIn\x12\x19\n" +
+	"\bmint_out\x18\x02 \x01(\fR\amintOut\x12\x1b\n" +
+	"\tamount_in\x18\x03 \x01(\x04R\bamountIn\x12\x1d\n" +
+	"\n" +
+	"amount_out\x18\x04 \x01(\x04R\tamountOut\x12\x14\n" +
+	"\x05price\x18\x05 \x01(\tR\x05price\x12\x16\n" +
+	"\x06source\x18\x06 \x01(\tR\x06source\"\x81\x02\n" +
+	"\x04Swap\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04pool\x18\x02 \x01(\fR\x04pool\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\fR\x04user\x12A\n" +
+	"\x0einput_transfer\x18\x04 \x01(\v2\x1a.solana_parser.v1.TransferR\rinputTransfer\x12C\n" +
+	"\x0foutput_transfer\x18\x05 \x01(\v2\x1a.solana_parser.v1.TransferR\x0eoutputTransfer\x127\n" +
+	"\aamounts\x18\x06 \x01(\v2\x1d.solana_parser.v1.SwapAmountsR\aamounts\"\v\n" +
+	"\tRoutePlan\"\xc1\x01\n" +
+	"\tRouteStep\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12*\n" +
+	"\x04swap\x18\x02 \x01(\v2\x16.solana_parser.v1.SwapR\x04swap\x12:\n" +
+	"\n" +
+	"route_plan\x18\x03 \x01(\v2\x1b.solana_parser.v1.RoutePlanR\troutePlan\x12:\n" +
+	"\n" +
+	"swap_event\x18\x04 \x01(\v2\x1b.solana_parser.v1.SwapEventR\tswapEvent\"q\n" +
+	"\x05Route\x12\x16\n" +
+	"\x06router\x18\x01 \x01(\fR\x06router\x12\x12\n" +
+	"\x04user\x18\x02 \x01(\fR\x04user\x12<\n" +
+	"\vroute_steps\x18\x03 \x03(\v2\x1b.solana_parser.v1.RouteStepR\n" +
+	"routeSteps\"\xb2\x01\n" +
+	"\bTransfer\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\fR\x04from\x12\x0e\n" +
+	"\x02to\x18\x04 \x01(\fR\x02to\x12\x16\n" +
+	"\x06amount\x18\x05 \x01(\x04R\x06amount\x12\x1a\n" +
+	"\bdecimals\x18\x06 \x01(\rR\bdecimals\x12\x1b\n" +
+	"\tui_amount\x18\a \x01(\tR\buiAmount\"\xa6\x01\n" +
+	"\x06MintTo\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\fR\aaccount\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12\x1a\n" +
+	"\bdecimals\x18\x05 \x01(\rR\bdecimals\x12\x1b\n" +
+	"\tui_amount\x18\x06 \x01(\tR\buiAmount\"\xa4\x01\n" +
+	"\x04Burn\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x18\n" +
+	"\aaccount\x18\x03 \x01(\fR\aaccount\x12\x16\n" +
+	"\x06amount\x18\x04 \x01(\x04R\x06amount\x12\x1a\n" +
+	"\bdecimals\x18\x05 \x01(\rR\bdecimals\x12\x1b\n" +
+	"\tui_amount\x18\x06 \x01(\tR\buiAmount\"o\n" +
+	"\n" +
+	"Initialize\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x18\n" +
+	"\aaccount\x18\x02 \x01(\fR\aaccount\x12\x14\n" +
+	"\x05owner\x18\x03 \x01(\fR\x05owner\x12\x12\n" +
+	"\x04mint\x18\x04 \x01(\fR\x04mint\"\xd8\x01\n" +
+	"\n" +
+	"MemeCreate\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\fR\x04user\x12#\n" +
+	"\rbonding_curve\x18\x04 \x01(\fR\fbondingCurve\x128\n" +
+	"\x18associated_bonding_curve\x18\x05 \x01(\fR\x16associatedBondingCurve\x121\n" +
+	"\amint_to\x18\x06 \x01(\v2\x18.solana_parser.v1.MintToR\x06mintTo\"\x9a\x03\n" +
+	"\aMemeBuy\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\fR\x04user\x12#\n" +
+	"\rbonding_curve\x18\x04 \x01(\fR\fbondingCurve\x128\n" +
+	"\x18associated_bonding_curve\x18\x05 \x01(\fR\x16associatedBondingCurve\x12?\n" +
+	"\rmint_transfer\x18\x06 \x01(\v2\x1a.solana_parser.v1.TransferR\fmintTransfer\x12=\n" +
+	"\fsol_transfer\x18\a \x01(\v2\x1a.solana_parser.v1.TransferR\vsolTransfer\x12=\n" +
+	"\ffee_transfer\x18\b \x01(\v2\x1a.solana_parser.v1.TransferR\vfeeTransfer\x127\n" +
+	"\aamounts\x18\t \x01(\v2\x1d.solana_parser.v1.SwapAmountsR\aamounts\"\x9d\x02\n" +
+	"\bMemeSell\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x12\n" +
+	"\x04user\x18\x03 \x01(\fR\x04user\x12#\n" +
+	"\rbonding_curve\x18\x04 \x01(\fR\fbondingCurve\x128\n" +
+	"\x18associated_bonding_curve\x18\x05 \x01(\fR\x16associatedBondingCurve\x12?\n" +
+	"\rmint_transfer\x18\x06 \x01(\v2\x1a.solana_parser.v1.TransferR\fmintTransfer\x127\n" +
+	"\aamounts\x18\a \x01(\v2\x1d.solana_parser.v1.SwapAmountsR\aamounts\"\x8b\x02\n" +
+	"\x12UnknownInstruction\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12!\n" +
+	"\fprogram_name\x18\x02 \x01(\tR\vprogramName\x12&\n" +
+	"\x0ediscriminator1\x18\x03 \x01(\tR\x0ediscriminator1\x12&\n" +
+	"\x0ediscriminator4\x18\x04 \x01(\tR\x0ediscriminator4\x12&\n" +
+	"\x0ediscriminator8\x18\x05 \x01(\tR\x0ediscriminator8\x12\x1f\n" +
+	"\vdata_length\x18\x06 \x01(\x03R\n" +
+	"dataLength\x12\x1a\n" +
+	"\baccounts\x18\a \x03(\fR\baccounts\"\xdf\x01\n" +
+	"\x04Pool\x12\x10\n" +
+	"\x03dex\x18\x01 \x01(\fR\x03dex\x12\x12\n" +
+	"\x04hash\x18\x02 \x01(\fR\x04hash\x12\x15\n" +
+	"\x06mint_a\x18\x03 \x01(\fR\x05mintA\x12\x15\n" +
+	"\x06mint_b\x18\x04 \x01(\fR\x05mintB\x12\x17\n" +
+	"\amint_lp\x18\x05 \x01(\fR\x06mintLp\x12\x17\n" +
+	"\avault_a\x18\x06 \x01(\fR\x06vaultA\x12\x17\n" +
+	"\avault_b\x18\a \x01(\fR\x06vaultB\x12\x1b\n" +
+	"\treserve_a\x18\b \x01(\x04R\breserveA\x12\x1b\n" +
+	"\treserve_b\x18\t \x01(\x04R\breserveB\"\xbb\x01\n" +
+	"\x0fMemeCreateEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06symbol\x18\x03 \x01(\tR\x06symbol\x12\x10\n" +
+	"\x03uri\x18\x04 \x01(\tR\x03uri\x12\x12\n" +
+	"\x04mint\x18\x05 \x01(\fR\x04mint\x12#\n" +
+	"\rbonding_curve\x18\x06 \x01(\fR\fbondingCurve\x12\x12\n" +
+	"\x04user\x18\a \x01(\fR\x04user\"\xb4\x02\n" +
+	"\fMemeBuyEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x1d\n" +
+	"\n" +
+	"sol_amount\x18\x03 \x01(\x04R\tsolAmount\x12!\n" +
+	"\ftoken_amount\x18\x04 \x01(\x04R\vtokenAmount\x12\x15\n" +
+	"\x06is_buy\x18\x05 \x01(\bR\x05isBuy\x12\x12\n" +
+	"\x04user\x18\x06 \x01(\fR\x04user\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\x120\n" +
+	"\x14virtual_sol_reserves\x18\b \x01(\x04R\x12virtualSolReserves\x124\n" +
+	"\x16virtual_token_reserves\x18\t \x01(\x04R\x14virtualTokenReserves\"\xb5\x02\n" +
+	"\rMemeSellEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04mint\x18\x02 \x01(\fR\x04mint\x12\x1d\n" +
+	"\n" +
+	"sol_amount\x18\x03 \x01(\x04R\tsolAmount\x12!\n" +
+	"\ftoken_amount\x18\x04 \x01(\x04R\vtokenAmount\x12\x15\n" +
+	"\x06is_buy\x18\x05 \x01(\bR\x05isBuy\x12\x12\n" +
+	"\x04user\x18\x06 \x01(\fR\x04user\x12\x1c\n" +
+	"\ttimestamp\x18\a \x01(\x03R\ttimestamp\x120\n" +
+	"\x14virtual_sol_reserves\x18\b \x01(\x04R\x12virtualSolReserves\x124\n" +
+	"\x16virtual_token_reserves\x18\t \x01(\x04R\x14virtualTokenReserves\"\xe6\x02\n" +
+	"\tSwapEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x10\n" +
+	"\x03amm\x18\x02 \x01(\fR\x03amm\x12\x1d\n" +
+	"\n" +
+	"input_mint\x18\x03 \x01(\fR\tinputMint\x12!\n" +
+	"\finput_amount\x18\x04 \x01(\x04R\vinputAmount\x12%\n" +
+	"\x0einput_decimals\x18\x05 \x01(\rR\rinputDecimals\x12&\n" +
+	"\x0finput_ui_amount\x18\x06 \x01(\tR\rinputUiAmount\x12\x1f\n" +
+	"\voutput_mint\x18\a \x01(\fR\n" +
+	"outputMint\x12#\n" +
+	"\routput_amount\x18\b \x01(\x04R\foutputAmount\x12'\n" +
+	"\x0foutput_decimals\x18\t \x01(\rR\x0eoutputDecimals\x12(\n" +
+	"\x10output_ui_amount\x18\n" +
+	" \x01(\tR\x0eoutputUiAmount\"\xde\x02\n" +
+	"\rDlmmSwapEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x17\n" +
+	"\alb_pair\x18\x02 \x01(\fR\x06lbPair\x12\x12\n" +
+	"\x04from\x18\x03 \x01(\fR\x04from\x12 \n" +
+	"\fstart_bin_id\x18\x04 \x01(\x11R\n" +
+	"startBinId\x12\x1c\n" +
+	"\n" +
+	"end_bin_id\x18\x05 \x01(\x11R\bendBinId\x12\x1b\n" +
+	"\tamount_in\x18\x06 \x01(\x04R\bamountIn\x12\x1d\n" +
+	"\n" +
+	"amount_out\x18\a \x01(\x04R\tamountOut\x12\x1c\n" +
+	"\n" +
+	"swap_for_y\x18\b \x01(\bR\bswapForY\x12\x10\n" +
+	"\x03fee\x18\t \x01(\x04R\x03fee\x12!\n" +
+	"\fprotocol_fee\x18\n" +
+	" \x01(\x04R\vprotocolFee\x12\x17\n" +
+	"\afee_bps\x18\v \x01(\tR\x06feeBps\x12\x19\n" +
+	"\bhost_fee\x18\f \x01(\x04R\ahostFee\"\xab\x03\n" +
+	"\rClmmSwapEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x1d\n" +
+	"\n" +
+	"pool_state\x18\x02 \x01(\fR\tpoolState\x12\x16\n" +
+	"\x06sender\x18\x03 \x01(\fR\x06sender\x12%\n" +
+	"\x0etoken_account0\x18\x04 \x01(\fR\rtokenAccount0\x12%\n" +
+	"\x0etoken_account1\x18\x05 \x01(\fR\rtokenAccount1\x12\x18\n" +
+	"\aamount0\x18\x06 \x01(\x04R\aamount0\x12#\n" +
+	"\rtransfer_fee0\x18\a \x01(\x04R\ftransferFee0\x12\x18\n" +
+	"\aamount1\x18\b \x01(\x04R\aamount1\x12#\n" +
+	"\rtransfer_fee1\x18\t \x01(\x04R\ftransferFee1\x12 \n" +
+	"\fzero_for_one\x18\n" +
+	" \x01(\bR\n" +
+	"zeroForOne\x12$\n" +
+	"\x0esqrt_price_x64\x18\v \x01(\tR\fsqrtPriceX64\x12\x1c\n" +
+	"\tliquidity\x18\f \x01(\tR\tliquidity\x12\x12\n" +
+	"\x04tick\x18\r \x01(\x11R\x04tick\"\xe8\x02\n" +
+	"\vCpSwapEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x17\n" +
+	"\apool_id\x18\x02 \x01(\fR\x06poolId\x12,\n" +
+	"\x12input_vault_before\x18\x03 \x01(\x04R\x10inputVaultBefore\x12.\n" +
+	"\x13output_vault_before\x18\x04 \x01(\x04R\x11outputVaultBefore\x12!\n" +
+	"\finput_amount\x18\x05 \x01(\x04R\vinputAmount\x12#\n" +
+	"\routput_amount\x18\x06 \x01(\x04R\foutputAmount\x12,\n" +
+	"\x12input_transfer_fee\x18\a \x01(\x04R\x10inputTransferFee\x12.\n" +
+	"\x13output_transfer_fee\x18\b \x01(\x04R\x11outputTransferFee\x12\x1d\n" +
+	"\n" +
+	"base_input\x18\t \x01(\bR\tbaseInput\"\xe8\x01\n" +
+	"\x12DecodedInstruction\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x12\n" +
+	"\x04args\x18\x03 \x01(\fR\x04args\x12N\n" +
+	"\baccounts\x18\x04 \x03(\v22.solana_parser.v1.DecodedInstruction.AccountsEntryR\baccounts\x1a;\n" +
+	"\rAccountsEntry\x12\x10\n" +
+	"\x03key\x18\x01 \x01(\tR\x03key\x12\x14\n" +
+	"\x05value\x18\x02 \x01(\fR\x05value:\x028\x01\"Y\n" +
+	"\fDecodedEvent\x12\x1d\n" +
+	"\n" +
+	"program_id\x18\x01 \x01(\fR\tprogramId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x16\n" +
+	"\x06fields\x18\x03 \x01(\fR\x06fieldsB0Z.github.com/blockchain-develop/solana-parser/pbb\x06proto3"
+
+var (
+	file_parser_proto_rawDescOnce sync.Once
+	file_parser_proto_rawDescData []byte
+)
+
+func file_parser_proto_rawDescGZIP() []byte {
+	file_parser_proto_rawDescOnce.Do(func() {
+		file_parser_proto_rawDescData = protoimpl.X.CompressGZIP(unsafe.Slice(unsafe.StringData(file_parser_proto_rawDesc), len(file_parser_proto_rawDesc)))
+	})
+	return file_parser_proto_rawDescData
+}
+
+var file_parser_proto_msgTypes = make([]protoimpl.MessageInfo, 40)
+var file_parser_proto_goTypes = []any{
+	(*Block)(nil),              // 0: solana_parser.v1.Block
+	(*Transaction)(nil),        // 1: solana_parser.v1.Transaction
+	(*AccountMeta)(nil),        // 2: solana_parser.v1.AccountMeta
+	(*Instruction)(nil),        // 3: solana_parser.v1.Instruction
+	(*TokenAccount)(nil),       // 4: solana_parser.v1.TokenAccount
+	(*MintAccount)(nil),        // 5: solana_parser.v1.MintAccount
+	(*Balance)(nil),            // 6: solana_parser.v1.Balance
+	(*BalanceChange)(nil),      // 7: solana_parser.v1.BalanceChange
+	(*Meta)(nil),               // 8: solana_parser.v1.Meta
+	(*Diagnostic)(nil),         // 9: solana_parser.v1.Diagnostic
+	(*PrimaryAction)(nil),      // 10: solana_parser.v1.PrimaryAction
+	(*Event)(nil),              // 11: solana_parser.v1.Event
+	(*OtherEvent)(nil),         // 12: solana_parser.v1.OtherEvent
+	(*CreatePool)(nil),         // 13: solana_parser.v1.CreatePool
+	(*AddLiquidity)(nil),       // 14: solana_parser.v1.AddLiquidity
+	(*RemoveLiquidity)(nil),    // 15: solana_parser.v1.RemoveLiquidity
+	(*SwapAmounts)(nil),        // 16: solana_parser.v1.SwapAmounts
+	(*Swap)(nil),               // 17: solana_parser.v1.Swap
+	(*RoutePlan)(nil),          // 18: solana_parser.v1.RoutePlan
+	(*RouteStep)(nil),          // 19: solana_parser.v1.RouteStep
+	(*Route)(nil),              // 20: solana_parser.v1.Route
+	(*Transfer)(nil),           // 21: solana_parser.v1.Transfer
+	(*MintTo)(nil),             // 22: solana_parser.v1.MintTo
+	(*Burn)(nil),               // 23: solana_parser.v1.Burn
+	(*Initialize)(nil),         // 24: solana_parser.v1.Initialize
+	(*MemeCreate)(nil),         // 25: solana_parser.v1.MemeCreate
+	(*MemeBuy)(nil),            // 26: solana_parser.v1.MemeBuy
+	(*MemeSell)(nil),           // 27: solana_parser.v1.MemeSell
+	(*UnknownInstruction)(nil), // 28: solana_parser.v1.UnknownInstruction
+	(*Pool)(nil),               // 29: solana_parser.v1.Pool
+	(*MemeCreateEvent)(nil),    // 30: solana_parser.v1.MemeCreateEvent
+	(*MemeBuyEvent)(nil),       // 31: solana_parser.v1.MemeBuyEvent
+	(*MemeSellEvent)(nil),      // 32: solana_parser.v1.MemeSellEvent
+	(*SwapEvent)(nil),          // 33: solana_parser.v1.SwapEvent
+	(*DlmmSwapEvent)(nil),      // 34: solana_parser.v1.DlmmSwapEvent
+	(*ClmmSwapEvent)(nil),      // 35: solana_parser.v1.ClmmSwapEvent
+	(*CpSwapEvent)(nil),        // 36: solana_parser.v1.CpSwapEvent
+	(*DecodedInstruction)(nil), // 37: solana_parser.v1.DecodedInstruction
+	(*DecodedEvent)(nil),       // 38: solana_parser.v1.DecodedEvent
+	nil,                        // 39: solana_parser.v1.DecodedInstruction.AccountsEntry
+}
+var file_parser_proto_depIdxs = []int32{
+	1,  // 0: solana_parser.v1.Block.transactions:type_name -> solana_parser.v1.Transaction
+	9,  // 1: solana_parser.v1.Block.diagnostics:type_name -> solana_parser.v1.Diagnostic
+	3,  // 2: solana_parser.v1.Transaction.instructions:type_name -> solana_parser.v1.Instruction
+	8,  // 3: solana_parser.v1.Transaction.meta:type_name -> solana_parser.v1.Meta
+	9,  // 4: solana_parser.v1.Transaction.diagnostics:type_name -> solana_parser.v1.Diagnostic
+	10, // 5: solana_parser.v1.Transaction.actions:type_name -> solana_parser.v1.PrimaryAction
+	2,  // 6: solana_parser.v1.Instruction.accounts:type_name -> solana_parser.v1.AccountMeta
+	11, // 7: solana_parser.v1.Instruction.events:type_name -> solana_parser.v1.Event
+	11, // 8: solana_parser.v1.Instruction.receipts:type_name -> solana_parser.v1.Event
+	3,  // 9: solana_parser.v1.Instruction.children:type_name -> solana_parser.v1.Instruction
+	2,  // 10: solana_parser.v1.Meta.accounts:type_name -> solana_parser.v1.AccountMeta
+	4,  // 11: solana_parser.v1.Meta.token_accounts:type_name -> solana_parser.v1.TokenAccount
+	5,  // 12: solana_parser.v1.Meta.mint_accounts:type_name -> solana_parser.v1.MintAccount
+	6,  // 13: solana_parser.v1.Meta.token_pre_balance:type_name -> solana_parser.v1.Balance
+	6,  // 14: solana_parser.v1.Meta.token_post_balance:type_name -> solana_parser.v1.Balance
+	6,  // 15: solana_parser.v1.Meta.sol_pre_balance:type_name -> solana_parser.v1.Balance
+	6,  // 16: solana_parser.v1.Meta.sol_post_balance:type_name -> solana_parser.v1.Balance
+	6,  // 17: solana_parser.v1.Meta.sol_balance_delta:type_name -> solana_parser.v1.Balance
+	7,  // 18: solana_parser.v1.Meta.balance_changes:type_name -> solana_parser.v1.BalanceChange
+	11, // 19: solana_parser.v1.PrimaryAction.action:type_name -> solana_parser.v1.Event
+	13, // 20: solana_parser.v1.Event.create_pool:type_name -> solana_parser.v1.CreatePool
+	14, // 21: solana_parser.v1.Event.add_liquidity:type_name -> solana_parser.v1.AddLiquidity
+	15, // 22: solana_parser.v1.Event.remove_liquidity:type_name -> solana_parser.v1.RemoveLiquidity
+	17, // 23: solana_parser.v1.Event.swap:type_name -> solana_parser.v1.Swap
+	20, // 24: solana_parser.v1.Event.route:type_name -> solana_parser.v1.Route
+	21, // 25: solana_parser.v1.Event.transfer:type_name -> solana_parser.v1.Transfer
+	22, // 26: solana_parser.v1.Event.mint_to:type_name -> solana_parser.v1.MintTo
+	23, // 27: solana_parser.v1.Event.burn:type_name -> solana_parser.v1.Burn
+	24, // 28: solana_parser.v1.Event.initialize:type_name -> solana_parser.v1.Initialize
+	25, // 29: solana_parser.v1.Event.meme_create:type_name -> solana_parser.v1.MemeCreate
+	26, // 30: solana_parser.v1.Event.meme_buy:type_name -> solana_parser.v1.MemeBuy
+	27, // 31: solana_parser.v1.Event.meme_sell:type_name -> solana_parser.v1.MemeSell
+	28, // 32: solana_parser.v1.Event.unknown_instruction:type_name -> solana_parser.v1.UnknownInstruction
+	29, // 33: solana_parser.v1.Event.pool:type_name -> solana_parser.v1.Pool
+	30, // 34: solana_parser.v1.Event.meme_create_event:type_name -> solana_parser.v1.MemeCreateEvent
+	31, // 35: solana_parser.v1.Event.meme_buy_event:type_name -> solana_parser.v1.MemeBuyEvent
+	32, // 36: solana_parser.v1.Event.meme_sell_event:type_name -> solana_parser.v1.MemeSellEvent
+	33, // 37: solana_parser.v1.Event.swap_event:type_name -> solana_parser.v1.SwapEvent
+	34, // 38: solana_parser.v1.Event.dlmm_swap_event:type_name -> solana_parser.v1.DlmmSwapEvent
+	35, // 39: solana_parser.v1.Event.clmm_swap_event:type_name -> solana_parser.v1.ClmmSwapEvent
+	36, // 40: solana_parser.v1.Event.cp_swap_event:type_name -> solana_parser.v1.CpSwapEvent
+	37, // 41: solana_parser.v1.Event.decoded_instruction:type_name -> solana_parser.v1.DecodedInstruction
+	38, // 42: solana_parser.v1.Event.decoded_event:type_name -> solana_parser.v1.DecodedEvent
+	12, // 43: solana_parser.v1.Event.other:type_name -> solana_parser.v1.OtherEvent
+	21, // 44: solana_parser.v1.AddLiquidity.token_a_transfer:type_name -> solana_parser.v1.Transfer
+	21, // 45: solana_parser.v1.AddLiquidity.token_b_transfer:type_name -> solana_parser.v1.Transfer
+	22, // 46: solana_parser.v1.AddLiquidity.token_lp_mint:type_name -> solana_parser.v1.MintTo
+	21, // 47: solana_parser.v1.RemoveLiquidity.token_a_transfer:type_name -> solana_parser.v1.Transfer
+	21, // 48: solana_parser.v1.RemoveLiquidity.token_b_transfer:type_name -> solana_parser.v1.Transfer
+	23, // 49: solana_parser.v1.RemoveLiquidity.token_lp_burn:type_name -> solana_parser.v1.Burn
+	21, // 50: solana_parser.v1.Swap.input_transfer:type_name -> solana_parser.v1.Transfer
+	21, // 51: solana_parser.v1.Swap.output_transfer:type_name -> solana_parser.v1.Transfer
+	16, // 52: solana_parser.v1.Swap.amounts:type_name -> solana_parser.v1.SwapAmounts
+	17, // 53: solana_parser.v1.RouteStep.swap:type_name -> solana_parser.v1.Swap
+	18, // 54: solana_parser.v1.RouteStep.route_plan:type_name -> solana_parser.v1.RoutePlan
+	33, // 55: solana_parser.v1.RouteStep.swap_event:type_name -> solana_parser.v1.SwapEvent
+	19, // 56: solana_parser.v1.Route.route_steps:type_name -> solana_parser.v1.RouteStep
+	22, // 57: solana_parser.v1.MemeCreate.mint_to:type_name -> solana_parser.v1.MintTo
+	21, // 58: solana_parser.v1.MemeBuy.mint_transfer:type_name -> solana_parser.v1.Transfer
+	21, // 59: solana_parser.v1.MemeBuy.sol_transfer:type_name -> solana_parser.v1.Transfer
+	21, // 60: solana_parser.v1.MemeBuy.fee_transfer:type_name -> solana_parser.v1.Transfer
+	16, // 61: solana_parser.v1.MemeBuy.amounts:type_name -> solana_parser.v1.SwapAmounts
+	21, // 62: solana_parser.v1.MemeSell.mint_transfer:type_name -> solana_parser.v1.Transfer
+	16, // 63: solana_parser.v1.MemeSell.amounts:type_name -> solana_parser.v1.SwapAmounts
+	39, // 64: solana_parser.v1.DecodedInstruction.accounts:type_name -> solana_parser.v1.DecodedInstruction.AccountsEntry
+	65, // [65:65] is the sub-list for method output_type
+	65, // [65:65] is the sub-list for method input_type
+	65, // [65:65] is the sub-list for extension type_name
+	65, // [65:65] is the sub-list for extension extendee
+	0,  // [0:65] is the sub-list for field type_name
+}
+
+func init() { file_parser_proto_init() }
+func file_parser_proto_init() {
+	if File_parser_proto != nil {
+		return
+	}
+	file_parser_proto_msgTypes[11].OneofWrappers = []any{
+		(*Event_CreatePool)(nil),
+		(*Event_AddLiquidity)(nil),
+		(*Event_RemoveLiquidity)(nil),
+		(*Event_Swap)(nil),
+		(*Event_Route)(nil),
+		(*Event_Transfer)(nil),
+		(*Event_MintTo)(nil),
+		(*Event_Burn)(nil),
+		(*Event_Initialize)(nil),
+		(*Event_MemeCreate)(nil),
+		(*Event_MemeBuy)(nil),
+		(*Event_MemeSell)(nil),
+		(*Event_UnknownInstruction)(nil),
+		(*Event_Pool)(nil),
+		(*Event_MemeCreateEvent)(nil),
+		(*Event_MemeBuyEvent)(nil),
+		(*Event_MemeSellEvent)(nil),
+		(*Event_SwapEvent)(nil),
+		(*Event_DlmmSwapEvent)(nil),
+		(*Event_ClmmSwapEvent)(nil),
+		(*Event_CpSwapEvent)(nil),
+		(*Event_DecodedInstruction)(nil),
+		(*Event_DecodedEvent)(nil),
+		(*Event_Other)(nil),
+	}
+	type x struct{}
+	out := protoimpl.TypeBuilder{
+		File: protoimpl.DescBuilder{
+			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
+			RawDescriptor: unsafe.Slice(unsafe.StringData(file_parser_proto_rawDesc), len(file_parser_proto_rawDesc)),
+			NumEnums:      0,
+			NumMessages:   40,
+			NumExtensions: 0,
+			NumServices:   0,
+		},
+		GoTypes:           file_parser_proto_goTypes,
+		DependencyIndexes: file_parser_proto_depIdxs,
+		MessageInfos:      file_parser_proto_msgTypes,
+	}.Build()
+	File_parser_proto = out.File
+	file_parser_proto_goTypes = nil
+	file_parser_proto_depIdxs = nil
+}
@@ -0,0 +1,60 @@
+// Package pb holds the protobuf schema of the parsed blocks & transactions, parser.proto, with its generated go types,
+// & the converters from & to the types package, so that the output of the parser can be sent over grpc or kafka
+// & read by consumers in any language.
+//
+// Parsed instructions & the args of decoded instructions & events are carried as json,
+// events shared by several events, e.g. the transfers of a swap & of its inner instructions, are copied.
+package pb
+
+//go:generate protoc --go_out=. --go_opt=paths=source_relative parser.proto
+
+import (
+	"errors"
+	"fmt"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"google.golang.org/protobuf/proto"
+)
+
+// Version is the version of the schema, the package of parser.proto is solana_parser.v<Version>
+const Version = 1
+
+// ErrInvalidMessage is returned when the bytes are not a message or the message does not convert to the types,
+// e.g. a key is not 32 bytes
+var ErrInvalidMessage = errors.New("invalid protobuf message")
+
+// MarshalBlock encodes a block as a solana_parser.v1.Block message, the encoding is deterministic
+func MarshalBlock(block *types.Block) ([]byte, error) {
+	m, err := FromBlock(block)
+	if err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(m)
+}
+
+// UnmarshalBlock decodes a solana_parser.v1.Block message
+func UnmarshalBlock(data []byte) (*types.Block, error) {
+	m := &Block{}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMessage, err)
+	}
+	return ToBlock(m)
+}
+
+// MarshalTransaction encodes a transaction as a solana_parser.v1.Transaction message, the encoding is deterministic
+func MarshalTransaction(t *types.Transaction) ([]byte, error) {
+	m, err := FromTransaction(t)
+	if err != nil {
+		return nil, err
+	}
+	return proto.MarshalOptions{Deterministic: true}.Marshal(m)
+}
+
+// UnmarshalTransaction decodes a solana_parser.v1.Transaction message
+func UnmarshalTransaction(data []byte) (*types.Transaction, error) {
+	m := &Transaction{}
+	if err := proto.Unmarshal(data, m); err != nil {
+		return nil, fmt.Errorf("%w: %s", ErrInvalidMessage, err)
+	}
+	return ToTransaction(m)
+}
@@ -0,0 +1,575 @@
+package pb
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
+
+// ToBlock converts a message to a block
+func ToBlock(m *Block) (*types.Block, error) {
+	c := &toConverter{}
+	block := &types.Block{
+		Time: m.GetTime(),
+		Slot: m.GetSlot(),
+	}
+	copy(block.Hash[:], c.fixed("block hash", m.GetHash(), solana.PublicKeyLength))
+	for _, t := range m.GetTransactions() {
+		block.Transaction = append(block.Transaction, c.transaction(t))
+	}
+	for _, diagnostic := range m.GetDiagnostics() {
+		block.Diagnostics = append(block.Diagnostics, c.diagnostic(diagnostic))
+	}
+	if c.err != nil {
+		return nil, c.err
+	}
+	return block, nil
+}
+
+// ToTransaction converts a message to a transaction & links the instructions to their parent
+func ToTransaction(m *Transaction) (*types.Transaction, error) {
+	c := &toConverter{}
+	t := c.transaction(m)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return t, nil
+}
+
+// ToEvent converts a message to an event, it is nil if the event is of a kind added by a later version of the schema
+func ToEvent(m *Event) (types.Event, error) {
+	c := &toConverter{}
+	event := c.event(m)
+	if c.err != nil {
+		return nil, c.err
+	}
+	return event, nil
+}
+
+// toConverter keeps the first error of a conversion, wrapped in ErrInvalidMessage
+type toConverter struct {
+	err error
+}
+
+func (c *toConverter) fail(format string, args ...interface{}) {
+	if c.err == nil {
+		c.err = fmt.Errorf("%w: %s", ErrInvalidMessage, fmt.Sprintf(format, args...))
+	}
+}
+
+// fixed checks the length of a signature or a key, empty is the zero value
+func (c *toConverter) fixed(name string, v []byte, length int) []byte {
+	if len(v) != 0 && len(v) != length {
+		c.fail("%s has %d bytes instead of %d", name, len(v), length)
+		return nil
+	}
+	return v
+}
+
+func (c *toConverter) key(name string, v []byte) solana.PublicKey {
+	var key solana.PublicKey
+	copy(key[:], c.fixed(name, v, solana.PublicKeyLength))
+	return key
+}
+
+func (c *toConverter) decimal(name string, v string) decimal.Decimal {
+	if v == "" {
+		return decimal.Decimal{}
+	}
+	result, err := decimal.NewFromString(v)
+	if err != nil {
+		c.fail("%s is not a decimal: %s", name, err)
+	}
+	return result
+}
+
+func (c *toConverter) uint8(name string, v uint32) uint8 {
+	if v > 0xff {
+		c.fail("%s %d overflows uint8", name, v)
+	}
+	return uint8(v)
+}
+
+// json decodes json bytes into v, empty bytes leave v unset
+func (c *toConverter) json(name string, data []byte, v interface{}) {
+	if len(data) == 0 {
+		return
+	}
+	if err := json.Unmarshal(data, v); err != nil {
+		c.fail("%s is not json: %s", name, err)
+	}
+}
+
+func (c *toConverter) transaction(m *Transaction) *types.Transaction {
+	t := &types.Transaction{
+		Time:   m.GetTime(),
+		Slot:   m.GetSlot(),
+		Seq:    int(m.GetSeq()),
+		Failed: m.GetFailed(),
+	}
+	copy(t.Hash[:], c.fixed("transaction hash", m.GetHash(), solana.SignatureLength))
+	for _, in := range m.GetInstructions() {
+		t.Instructions = append(t.Instructions, c.instruction(in, nil))
+	}
+	if m.Meta != nil {
+		t.Meta = c.meta(m.Meta)
+	}
+	for _, diagnostic := range m.GetDiagnostics() {
+		t.Diagnostics = append(t.Diagnostics, c.diagnostic(diagnostic))
+	}
+	for _, action := range m.GetActions() {
+		a := &types.PrimaryAction{
+			Path:     toPath(action.GetPath()),
+			Program:  c.key("action program", action.GetProgram()),
+			Name:     action.GetName(),
+			Type:     action.GetType(),
+			Priority: int(action.GetPriority()),
+		}
+		if action.Action != nil {
+			a.Action = c.event(action.Action)
+		}
+		t.Actions = append(t.Actions, a)
+	}
+	return t
+}
+
+func (c *toConverter) instruction(m *Instruction, parent *types.Instruction) *types.Instruction {
+	in := &types.Instruction{
+		Seq:    int(m.GetSeq()),
+		Path:   toPath(m.GetPath()),
+		Depth:  int(m.GetDepth()),
+		Parent: parent,
+		RawInstruction: &solana.GenericInstruction{
+			ProgID:        c.key("program id", m.GetProgramId()),
+			AccountValues: c.accountMetas(m.GetAccounts()),
+			DataBytes:     m.GetData(),
+		},
+		Logs:         m.GetLogs(),
+		ComputeUnits: m.GetComputeUnits(),
+		Success:      m.GetSuccess(),
+		Failed:       m.GetFailed(),
+		Error:        m.GetError(),
+	}
+	c.json("parsed instruction", m.GetParsedInstruction(), &in.ParsedInstruction)
+	in.Event = c.events(m.GetEvents())
+	in.Receipt = c.events(m.GetReceipts())
+	for _, child := range m.GetChildren() {
+		in.Children = append(in.Children, c.instruction(child, in))
+	}
+	return in
+}
+
+func (c *toConverter) accountMetas(accounts []*AccountMeta) []*solana.AccountMeta {
+	var result []*solana.AccountMeta
+	for _, account := range accounts {
+		result = append(result, &solana.AccountMeta{
+			PublicKey:  c.key("account", account.GetPublicKey()),
+			IsWritable: account.GetIsWritable(),
+			IsSigner:   account.GetIsSigner(),
+		})
+	}
+	return result
+}
+
+func (c *toConverter) meta(m *Meta) *types.Meta {
+	meta := &types.Meta{
+		Accounts:         c.accountMetas(m.GetAccounts()),
+		TokenAccounts:    make(map[solana.PublicKey]*types.TokenAccount),
+		MintAccounts:     make(map[solana.PublicKey]*types.MintAccount),
+		TokenPreBalance:  c.balances(m.GetTokenPreBalance()),
+		TokenPostBalance: c.balances(m.GetTokenPostBalance()),
+		SolPreBalance:    c.balances(m.GetSolPreBalance()),
+		SolPostBalance:   c.balances(m.GetSolPostBalance()),
+		SolBalanceDelta:  c.balances(m.GetSolBalanceDelta()),
+		ErrorMessage:     m.GetErrorMessage(),
+	}
+	for _, account := range m.GetTokenAccounts() {
+		tokenAccount := &types.TokenAccount{
+			Mint: c.key("token account mint", account.GetMint()),
+		}
+		if account.Owner != nil {
+			owner := c.key("token account owner", account.GetOwner())
+			tokenAccount.Owner = &owner
+		}
+		if account.ProgramId != nil {
+			programId := c.key("token account program id", account.GetProgramId())
+			tokenAccount.ProgramId = &programId
+		}
+		meta.TokenAccounts[c.key("token account", account.GetAccount())] = tokenAccount
+	}
+	for _, account := range m.GetMintAccounts() {
+		mint := c.key("mint account", account.GetMint())
+		meta.MintAccounts[mint] = &types.MintAccount{
+			Mint:     mint,
+			Decimals: c.uint8("mint decimals", account.GetDecimals()),
+		}
+	}
+	for _, change := range m.GetBalanceChanges() {
+		meta.BalanceChanges = append(meta.BalanceChanges, &types.BalanceChange{
+			Owner:  c.key("balance change owner", change.GetOwner()),
+			Mint:   c.key("balance change mint", change.GetMint()),
+			Pre:    c.decimal("balance change pre", change.GetPre()),
+			Post:   c.decimal("balance change post", change.GetPost()),
+			Change: c.decimal("balance change", change.GetChange()),
+		})
+	}
+	return meta
+}
+
+func (c *toConverter) balances(balances []*Balance) map[solana.PublicKey]decimal.Decimal {
+	result := make(map[solana.PublicKey]decimal.Decimal, len(balances))
+	for _, balance := range balances {
+		result[c.key("balance account", balance.GetAccount())] = c.decimal("balance", balance.GetAmount())
+	}
+	return result
+}
+
+func (c *toConverter) diagnostic(m *Diagnostic) *types.Diagnostic {
+	diagnostic := &types.Diagnostic{
+		Path:          toPath(m.GetPath()),
+		Account:       c.key("diagnostic account", m.GetAccount()),
+		Program:       c.key("diagnostic program", m.GetProgram()),
+		Discriminator: m.GetDiscriminator(),
+		Kind:          m.GetKind(),
+		Message:       m.GetMessage(),
+	}
+	copy(diagnostic.Signature[:], c.fixed("diagnostic signature", m.GetSignature(), solana.SignatureLength))
+	return diagnostic
+}
+
+// events drops the events of unknown kinds
+func (c *toConverter) events(events []*Event) []types.Event {
+	var result []types.Event
+	for _, m := range events {
+		if event := c.event(m); event != nil {
+			result = append(result, event)
+		}
+	}
+	return result
+}
+
+// event is nil if the field of the oneof is unknown
+func (c *toConverter) event(m *Event) types.Event {
+	switch m := m.GetEvent().(type) {
+	case *Event_CreatePool:
+		return &types.CreatePool{
+			Dex:     c.key("dex", m.CreatePool.GetDex()),
+			Pool:    c.key("pool", m.CreatePool.GetPool()),
+			User:    c.key("user", m.CreatePool.GetUser()),
+			TokenA:  c.key("token a", m.CreatePool.GetTokenA()),
+			TokenB:  c.key("token b", m.CreatePool.GetTokenB()),
+			TokenLP: c.key("token lp", m.CreatePool.GetTokenLp()),
+			VaultA:  c.key("vault a", m.CreatePool.GetVaultA()),
+			VaultB:  c.key("vault b", m.CreatePool.GetVaultB()),
+			VaultLP: c.key("vault lp", m.CreatePool.GetVaultLp()),
+		}
+	case *Event_AddLiquidity:
+		return &types.AddLiquidity{
+			Dex:            c.key("dex", m.AddLiquidity.GetDex()),
+			Pool:           c.key("pool", m.AddLiquidity.GetPool()),
+			User:           c.key("user", m.AddLiquidity.GetUser()),
+			TokenATransfer: c.transfer(m.AddLiquidity.GetTokenATransfer()),
+			TokenBTransfer: c.transfer(m.AddLiquidity.GetTokenBTransfer()),
+			TokenLpMint:    c.mintTo(m.AddLiquidity.GetTokenLpMint()),
+		}
+	case *Event_RemoveLiquidity:
+		return &types.RemoveLiquidity{
+			Dex:            c.key("dex", m.RemoveLiquidity.GetDex()),
+			Pool:           c.key("pool", m.RemoveLiquidity.GetPool()),
+			User:           c.key("user", m.RemoveLiquidity.GetUser()),
+			TokenATransfer: c.transfer(m.RemoveLiquidity.GetTokenATransfer()),
+			TokenBTransfer: c.transfer(m.RemoveLiquidity.GetTokenBTransfer()),
+			TokenLpBurn:    c.burn(m.RemoveLiquidity.GetTokenLpBurn()),
+		}
+	case *Event_Swap:
+		return c.swap(m.Swap)
+	case *Event_Route:
+		route := &types.Route{
+			Router: c.key("router", m.Route.GetRouter()),
+			User:   c.key("user", m.Route.GetUser()),
+		}
+		for _, step := range m.Route.GetRouteSteps() {
+			routeStep := &types.RouteStep{Dex: c.key("dex", step.GetDex())}
+			if step.Swap != nil {
+				routeStep.Swap = c.swap(step.Swap)
+			}
+			if step.RoutePlan != nil {
+				routeStep.RoutePlan = &types.RoutePlan{}
+			}
+			if step.SwapEvent != nil {
+				routeStep.SwapEvent = c.swapEvent(step.SwapEvent)
+			}
+			route.RouteSteps = append(route.RouteSteps, routeStep)
+		}
+		return route
+	case *Event_Transfer:
+		return c.transfer(m.Transfer)
+	case *Event_MintTo:
+		return c.mintTo(m.MintTo)
+	case *Event_Burn:
+		return c.burn(m.Burn)
+	case *Event_Initialize:
+		return &types.Initialize{
+			ProgramId: c.key("program id", m.Initialize.GetProgramId()),
+			Account:   c.key("account", m.Initialize.GetAccount()),
+			Owner:     c.key("owner", m.Initialize.GetOwner()),
+			Mint:      c.key("mint", m.Initialize.GetMint()),
+		}
+	case *Event_MemeCreate:
+		return &types.MemeCreate{
+			Dex:                    c.key("dex", m.MemeCreate.GetDex()),
+			Mint:                   c.key("mint", m.MemeCreate.GetMint()),
+			User:                   c.key("user", m.MemeCreate.GetUser()),
+			BondingCurve:           c.key("bonding curve", m.MemeCreate.GetBondingCurve()),
+			AssociatedBondingCurve: c.key("associated bonding curve", m.MemeCreate.GetAssociatedBondingCurve()),
+			MintTo:                 c.mintTo(m.MemeCreate.GetMintTo()),
+		}
+	case *Event_MemeBuy:
+		return &types.MemeBuy{
+			Dex:                    c.key("dex", m.MemeBuy.GetDex()),
+			Mint:                   c.key("mint", m.MemeBuy.GetMint()),
+			User:                   c.key("user", m.MemeBuy.GetUser()),
+			BondingCurve:           c.key("bonding curve", m.MemeBuy.GetBondingCurve()),
+			AssociatedBondingCurve: c.key("associated bonding curve", m.MemeBuy.GetAssociatedBondingCurve()),
+			MintTransfer:           c.transfer(m.MemeBuy.GetMintTransfer()),
+			SolTransfer:            c.transfer(m.MemeBuy.GetSolTransfer()),
+			FeeTransfer:            c.transfer(m.MemeBuy.GetFeeTransfer()),
+			SwapAmounts:            c.swapAmounts(m.MemeBuy.GetAmounts()),
+		}
+	case *Event_MemeSell:
+		return &types.MemeSell{
+			Dex:                    c.key("dex", m.MemeSell.GetDex()),
+			Mint:                   c.key("mint", m.MemeSell.GetMint()),
+			User:                   c.key("user", m.MemeSell.GetUser()),
+			BondingCurve:           c.key("bonding curve", m.MemeSell.GetBondingCurve()),
+			AssociatedBondingCurve: c.key("associated bonding curve", m.MemeSell.GetAssociatedBondingCurve()),
+			MintTransfer:           c.transfer(m.MemeSell.GetMintTransfer()),
+			SwapAmounts:            c.swapAmounts(m.MemeSell.GetAmounts()),
+		}
+	case *Event_UnknownInstruction:
+		unknown := &types.UnknownInstruction{
+			ProgramId:      c.key("program id", m.UnknownInstruction.GetProgramId()),
+			ProgramName:    m.UnknownInstruction.GetProgramName(),
+			Discriminator1: m.UnknownInstruction.GetDiscriminator1(),
+			Discriminator4: m.UnknownInstruction.GetDiscriminator4(),
+			Discriminator8: m.UnknownInstruction.GetDiscriminator8(),
+			DataLength:     int(m.UnknownInstruction.GetDataLength()),
+		}
+		for _, account := range m.UnknownInstruction.GetAccounts() {
+			unknown.Accounts = append(unknown.Accounts, c.key("account", account))
+		}
+		return unknown
+	case *Event_Pool:
+		return &types.Pool{
+			Dex:      c.key("dex", m.Pool.GetDex()),
+			Hash:     c.key("hash", m.Pool.GetHash()),
+			MintA:    c.key("mint a", m.Pool.GetMintA()),
+			MintB:    c.key("mint b", m.Pool.GetMintB()),
+			MintLp:   c.key("mint lp", m.Pool.GetMintLp()),
+			VaultA:   c.key("vault a", m.Pool.GetVaultA()),
+			VaultB:   c.key("vault b", m.Pool.GetVaultB()),
+			ReserveA: m.Pool.GetReserveA(),
+			ReserveB: m.Pool.GetReserveB(),
+		}
+	case *Event_MemeCreateEvent:
+		return &types.MemeCreateEvent{
+			ProgramId:    c.key("program id", m.MemeCreateEvent.GetProgramId()),
+			Name:         m.MemeCreateEvent.GetName(),
+			Symbol:       m.MemeCreateEvent.GetSymbol(),
+			Uri:          m.MemeCreateEvent.GetUri(),
+			Mint:         c.key("mint", m.MemeCreateEvent.GetMint()),
+			BondingCurve: c.key("bonding curve", m.MemeCreateEvent.GetBondingCurve()),
+			User:         c.key("user", m.MemeCreateEvent.GetUser()),
+		}
+	case *Event_MemeBuyEvent:
+		return &types.MemeBuyEvent{
+			ProgramId:            c.key("program id", m.MemeBuyEvent.GetProgramId()),
+			Mint:                 c.key("mint", m.MemeBuyEvent.GetMint()),
+			SolAmount:            m.MemeBuyEvent.GetSolAmount(),
+			TokenAmount:          m.MemeBuyEvent.GetTokenAmount(),
+			IsBuy:                m.MemeBuyEvent.GetIsBuy(),
+			User:                 c.key("user", m.MemeBuyEvent.GetUser()),
+			Timestamp:            m.MemeBuyEvent.GetTimestamp(),
+			VirtualSolReserves:   m.MemeBuyEvent.GetVirtualSolReserves(),
+			VirtualTokenReserves: m.MemeBuyEvent.GetVirtualTokenReserves(),
+		}
+	case *Event_MemeSellEvent:
+		return &types.MemeSellEvent{
+			ProgramId:            c.key("program id", m.MemeSellEvent.GetProgramId()),
+			Mint:                 c.key("mint", m.MemeSellEvent.GetMint()),
+			SolAmount:            m.MemeSellEvent.GetSolAmount(),
+			TokenAmount:          m.MemeSellEvent.GetTokenAmount(),
+			IsBuy:                m.MemeSellEvent.GetIsBuy(),
+			User:                 c.key("user", m.MemeSellEvent.GetUser()),
+			Timestamp:            m.MemeSellEvent.GetTimestamp(),
+			VirtualSolReserves:   m.MemeSellEvent.GetVirtualSolReserves(),
+			VirtualTokenReserves: m.MemeSellEvent.GetVirtualTokenReserves(),
+		}
+	case *Event_SwapEvent:
+		return c.swapEvent(m.SwapEvent)
+	case *Event_DlmmSwapEvent:
+		return &types.DlmmSwapEvent{
+			ProgramId:   c.key("program id", m.DlmmSwapEvent.GetProgramId()),
+			LbPair:      c.key("lb pair", m.DlmmSwapEvent.GetLbPair()),
+			From:        c.key("from", m.DlmmSwapEvent.GetFrom()),
+			StartBinId:  m.DlmmSwapEvent.GetStartBinId(),
+			EndBinId:    m.DlmmSwapEvent.GetEndBinId(),
+			AmountIn:    m.DlmmSwapEvent.GetAmountIn(),
+			AmountOut:   m.DlmmSwapEvent.GetAmountOut(),
+			SwapForY:    m.DlmmSwapEvent.GetSwapForY(),
+			Fee:         m.DlmmSwapEvent.GetFee(),
+			ProtocolFee: m.DlmmSwapEvent.GetProtocolFee(),
+			FeeBps:      c.decimal("fee bps", m.DlmmSwapEvent.GetFeeBps()),
+			HostFee:     m.DlmmSwapEvent.GetHostFee(),
+		}
+	case *Event_ClmmSwapEvent:
+		return &types.ClmmSwapEvent{
+			ProgramId:     c.key("program id", m.ClmmSwapEvent.GetProgramId()),
+			PoolState:     c.key("pool state", m.ClmmSwapEvent.GetPoolState()),
+			Sender:        c.key("sender", m.ClmmSwapEvent.GetSender()),
+			TokenAccount0: c.key("token account 0", m.ClmmSwapEvent.GetTokenAccount0()),
+			TokenAccount1: c.key("token account 1", m.ClmmSwapEvent.GetTokenAccount1()),
+			Amount0:       m.ClmmSwapEvent.GetAmount0(),
+			TransferFee0:  m.ClmmSwapEvent.GetTransferFee0(),
+			Amount1:       m.ClmmSwapEvent.GetAmount1(),
+			TransferFee1:  m.ClmmSwapEvent.GetTransferFee1(),
+			ZeroForOne:    m.ClmmSwapEvent.GetZeroForOne(),
+			SqrtPriceX64:  c.decimal("sqrt price x64", m.ClmmSwapEvent.GetSqrtPriceX64()),
+			Liquidity:     c.decimal("liquidity", m.ClmmSwapEvent.GetLiquidity()),
+			Tick:          m.ClmmSwapEvent.GetTick(),
+		}
+	case *Event_CpSwapEvent:
+		return &types.CpSwapEvent{
+			ProgramId:         c.key("program id", m.CpSwapEvent.GetProgramId()),
+			PoolId:            c.key("pool id", m.CpSwapEvent.GetPoolId()),
+			InputVaultBefore:  m.CpSwapEvent.GetInputVaultBefore(),
+			OutputVaultBefore: m.CpSwapEvent.GetOutputVaultBefore(),
+			InputAmount:       m.CpSwapEvent.GetInputAmount(),
+			OutputAmount:      m.CpSwapEvent.GetOutputAmount(),
+			InputTransferFee:  m.CpSwapEvent.GetInputTransferFee(),
+			OutputTransferFee: m.CpSwapEvent.GetOutputTransferFee(),
+			BaseInput:         m.CpSwapEvent.GetBaseInput(),
+		}
+	case *Event_DecodedInstruction:
+		decoded := &types.DecodedInstruction{
+			ProgramId: c.key("program id", m.DecodedInstruction.GetProgramId()),
+			Name:      m.DecodedInstruction.GetName(),
+		}
+		c.json("decoded instruction args", m.DecodedInstruction.GetArgs(), &decoded.Args)
+		if accounts := m.DecodedInstruction.GetAccounts(); len(accounts) > 0 {
+			decoded.Accounts = make(map[string]solana.PublicKey, len(accounts))
+			for name, account := range accounts {
+				decoded.Accounts[name] = c.key("decoded instruction account", account)
+			}
+		}
+		return decoded
+	case *Event_DecodedEvent:
+		decoded := &types.DecodedEvent{
+			ProgramId: c.key("program id", m.DecodedEvent.GetProgramId()),
+			Name:      m.DecodedEvent.GetName(),
+		}
+		c.json("decoded event fields", m.DecodedEvent.GetFields(), &decoded.Fields)
+		return decoded
+	case *Event_Other:
+		event, err := types.UnmarshalEvent(m.Other.GetJson())
+		if err != nil {
+			c.fail("other event %s: %s", m.Other.GetKind(), err)
+			return nil
+		}
+		return event
+	default:
+		return nil
+	}
+}
+
+func (c *toConverter) swap(m *Swap) *types.Swap {
+	return &types.Swap{
+		Dex:            c.key("dex", m.GetDex()),
+		Pool:           c.key("pool", m.GetPool()),
+		User:           c.key("user", m.GetUser()),
+		InputTransfer:  c.transfer(m.GetInputTransfer()),
+		OutputTransfer: c.transfer(m.GetOutputTransfer()),
+		SwapAmounts:    c.swapAmounts(m.GetAmounts()),
+	}
+}
+
+func (c *toConverter) swapEvent(m *SwapEvent) *types.SwapEvent {
+	return &types.SwapEvent{
+		ProgramId:      c.key("program id", m.GetProgramId()),
+		Amm:            c.key("amm", m.GetAmm()),
+		InputMint:      c.key("input mint", m.GetInputMint()),
+		InputAmount:    m.GetInputAmount(),
+		InputDecimals:  c.uint8("input decimals", m.GetInputDecimals()),
+		InputUiAmount:  c.decimal("input ui amount", m.GetInputUiAmount()),
+		OutputMint:     c.key("output mint", m.GetOutputMint()),
+		OutputAmount:   m.GetOutputAmount(),
+		OutputDecimals: c.uint8("output decimals", m.GetOutputDecimals()),
+		OutputUiAmount: c.decimal("output ui amount", m.GetOutputUiAmount()),
+	}
+}
+
+// swapAmounts is the zero value if the amounts are missing
+func (c *toConverter) swapAmounts(m *SwapAmounts) types.SwapAmounts {
+	return types.SwapAmounts{
+		MintIn:    c.key("mint in", m.GetMintIn()),
+		MintOut:   c.key("mint out", m.GetMintOut()),
+		AmountIn:  m.GetAmountIn(),
+		AmountOut: m.GetAmountOut(),
+		Price:     c.decimal("price", m.GetPrice()),
+		Source:    m.GetSource(),
+	}
+}
+
+func (c *toConverter) transfer(m *Transfer) *types.Transfer {
+	if m == nil {
+		return nil
+	}
+	return &types.Transfer{
+		ProgramId: c.key("program id", m.GetProgramId()),
+		Mint:      c.key("mint", m.GetMint()),
+		From:      c.key("from", m.GetFrom()),
+		To:        c.key("to", m.GetTo()),
+		Amount:    m.GetAmount(),
+		Decimals:  c.uint8("decimals", m.GetDecimals()),
+		UiAmount:  c.decimal("ui amount", m.GetUiAmount()),
+	}
+}
+
+func (c *toConverter) mintTo(m *MintTo) *types.MintTo {
+	if m == nil {
+		return nil
+	}
+	return &types.MintTo{
+		ProgramId: c.key("program id", m.GetProgramId()),
+		Mint:      c.key("mint", m.GetMint()),
+		Account:   c.key("account", m.GetAccount()),
+		Amount:    m.GetAmount(),
+		Decimals:  c.uint8("decimals", m.GetDecimals()),
+		UiAmount:  c.decimal("ui amount", m.GetUiAmount()),
+	}
+}
+
+func (c *toConverter) burn(m *Burn) *types.Burn {
+	if m == nil {
+		return nil
+	}
+	return &types.Burn{
+		ProgramId: c.key("program id", m.GetProgramId()),
+		Mint:      c.key("mint", m.GetMint()),
+		Account:   c.key("account", m.GetAccount()),
+		Amount:    m.GetAmount(),
+		Decimals:  c.uint8("decimals", m.GetDecimals()),
+		UiAmount:  c.decimal("ui amount", m.GetUiAmount()),
+	}
+}
+
+func toPath(path []int64) []int {
+	var result []int
+	for _, seq := range path {
+		result = append(result, int(seq))
+	}
+	return result
+}
@@ -0,0 +1,24 @@
+package anchor
+
+import (
+	"bytes"
+	"crypto/sha256"
+)
+
+var (
+	// Instruction_SelfCPILog is the instruction anchor programs invoke on themselves to emit events
+	Instruction_SelfCPILog = [8]byte{228, 69, 165, 46, 81, 203, 154, 29}
+)
+
+// EventDiscriminator is the first 8 bytes of sha256("event:<name>")
+func EventDiscriminator(name string) [8]byte {
+	var discriminator [8]byte
+	hash := sha256.Sum256([]byte("event:" + name))
+	copy(discriminator[:], hash[:8])
+	return discriminator
+}
+
+// IsSelfCPILog reports whether the instruction data is an anchor self CPI event log
+func IsSelfCPILog(data []byte) bool {
+	return len(data) >= 8 && bytes.Equal(data[:8], Instruction_SelfCPILog[:])
+}
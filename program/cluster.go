@@ -0,0 +1,46 @@
+package program
+
+import (
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/raydium_amm"
+	"github.com/gagliardetto/solana-go/programs/raydium_clmm"
+	"github.com/gagliardetto/solana-go/programs/raydium_cp"
+)
+
+// Cluster maps the registered program names to their ids on one cluster
+type Cluster struct {
+	Name     string
+	Programs map[string][]solana.PublicKey
+}
+
+var (
+	// Mainnet is the cluster of the ids the programs are registered with
+	Mainnet = NewCluster("mainnet", nil)
+	// Devnet lists the programs deployed at another id on devnet, the others are deployed at their mainnet id
+	Devnet = NewCluster("devnet", map[string][]solana.PublicKey{
+		raydium_amm.ProgramName:  {solana.MustPublicKeyFromBase58("HWy1jotHpo6UqeQxx49dpYYdQB8wj9Qk9MdxwjLvDHB8")},
+		raydium_clmm.ProgramName: {solana.MustPublicKeyFromBase58("devi51mZmdwUJGU9hjN27vEz64Gps7uUefqxg27EAtH")},
+		raydium_cp.ProgramName:   {solana.MustPublicKeyFromBase58("CPMDWBwJDtYax9qW7AyRuVC19Cc4L4Vcy4n4BHAW4Dms")},
+	})
+)
+
+// NewCluster creates a custom cluster, e.g. a local validator with redeployed copies of the programs
+func NewCluster(name string, programs map[string][]solana.PublicKey) *Cluster {
+	if programs == nil {
+		programs = make(map[string][]solana.PublicKey)
+	}
+	return &Cluster{
+		Name:     name,
+		Programs: programs,
+	}
+}
+
+// With returns a copy of the cluster with the ids of one more program
+func (c *Cluster) With(name string, ids ...solana.PublicKey) *Cluster {
+	programs := make(map[string][]solana.PublicKey, len(c.Programs)+1)
+	for k, v := range c.Programs {
+		programs[k] = v
+	}
+	programs[name] = append(append([]solana.PublicKey{}, programs[name]...), ids...)
+	return NewCluster(c.Name, programs)
+}
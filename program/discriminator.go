@@ -0,0 +1,27 @@
+package program
+
+import (
+	"github.com/gagliardetto/solana-go"
+	"github.com/gagliardetto/solana-go/programs/phoenix_v1"
+	"github.com/gagliardetto/solana-go/programs/raydium_amm"
+	"github.com/gagliardetto/solana-go/programs/solfi"
+)
+
+// discriminatorLengths are the lengths of the instruction discriminators of the programs which are not anchor programs,
+// the system program uses a u32 index & the other native programs a u8 index, anchor programs use 8 bytes
+var discriminatorLengths = map[solana.PublicKey]int{
+	solana.SystemProgramID:    4,
+	solana.TokenProgramID:     1,
+	solana.Token2022ProgramID: 1,
+	raydium_amm.ProgramID:     1,
+	phoenix_v1.ProgramID:      1,
+	solfi.ProgramID:           1,
+}
+
+// DiscriminatorLength is the length of the instruction discriminator of a registered program by its canonical id
+func DiscriminatorLength(id solana.PublicKey) int {
+	if length, ok := discriminatorLengths[id]; ok {
+		return length
+	}
+	return 8
+}
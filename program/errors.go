@@ -0,0 +1,9 @@
+package program
+
+import "errors"
+
+var (
+	ErrParserNotFound       = errors.New("parser not found")
+	ErrTokenAccountNotFound = errors.New("token account not found")
+	ErrEventNotFound        = errors.New("event not found")
+)
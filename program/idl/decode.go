@@ -0,0 +1,264 @@
+package idl
+
+import (
+	"bytes"
+	"encoding/binary"
+	"fmt"
+	"math"
+	"math/big"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// decoder reads borsh values described by the types of an idl
+type decoder struct {
+	idl  *Idl
+	data []byte
+	pos  int
+}
+
+func (d *decoder) read(n int) ([]byte, error) {
+	if n < 0 || d.pos+n > len(d.data) {
+		return nil, fmt.Errorf("unexpected end of data at %d, need %d bytes", d.pos, n)
+	}
+	b := d.data[d.pos : d.pos+n]
+	d.pos += n
+	return b, nil
+}
+
+func (d *decoder) readLength() (int, error) {
+	b, err := d.read(4)
+	if err != nil {
+		return 0, err
+	}
+	return int(binary.LittleEndian.Uint32(b)), nil
+}
+
+// decodeFields decodes named fields into a map
+func (d *decoder) decodeFields(fields []*Field) (map[string]interface{}, error) {
+	values := make(map[string]interface{}, len(fields))
+	for _, field := range fields {
+		value, err := d.decode(field.Type)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", field.Name, err)
+		}
+		values[field.Name] = value
+	}
+	return values, nil
+}
+
+func (d *decoder) decodeTuple(tuple []*Type) ([]interface{}, error) {
+	values := make([]interface{}, 0, len(tuple))
+	for i, t := range tuple {
+		value, err := d.decode(t)
+		if err != nil {
+			return nil, fmt.Errorf("%d: %w", i, err)
+		}
+		values = append(values, value)
+	}
+	return values, nil
+}
+
+// decode decodes one value, integers keep their go type, 128 bits integers are *big.Int,
+// structs are maps, enums are the variant name or a map from the variant name to its fields
+func (d *decoder) decode(t *Type) (interface{}, error) {
+	switch {
+	case t.Primitive != "":
+		return d.decodePrimitive(t.Primitive)
+	case t.Vec != nil:
+		if t.Vec.Primitive == "u8" {
+			return d.decodePrimitive("bytes")
+		}
+		n, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		values := make([]interface{}, 0)
+		for i := 0; i < n; i++ {
+			value, err := d.decode(t.Vec)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case t.Option != nil:
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+		if b[0] == 0 {
+			return nil, nil
+		}
+		return d.decode(t.Option)
+	case t.Array != nil:
+		if t.Array.Primitive == "u8" {
+			b, err := d.read(t.Len)
+			if err != nil {
+				return nil, err
+			}
+			return append([]byte{}, b...), nil
+		}
+		values := make([]interface{}, 0, t.Len)
+		for i := 0; i < t.Len; i++ {
+			value, err := d.decode(t.Array)
+			if err != nil {
+				return nil, err
+			}
+			values = append(values, value)
+		}
+		return values, nil
+	case t.Defined != "":
+		return d.decodeDefined(t.Defined)
+	}
+	return nil, fmt.Errorf("empty idl type")
+}
+
+func (d *decoder) decodeDefined(name string) (interface{}, error) {
+	typeDef, ok := d.idl.types[name]
+	if !ok {
+		return nil, fmt.Errorf("type %s is not defined", name)
+	}
+	ty := typeDef.Type
+	switch ty.Kind {
+	case "struct":
+		if ty.Tuple != nil {
+			return d.decodeTuple(ty.Tuple)
+		}
+		return d.decodeFields(ty.Fields)
+	case "enum":
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+		if int(b[0]) >= len(ty.Variants) {
+			return nil, fmt.Errorf("enum %s has no variant %d", name, b[0])
+		}
+		variant := ty.Variants[b[0]]
+		switch {
+		case variant.Tuple != nil:
+			values, err := d.decodeTuple(variant.Tuple)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{variant.Name: values}, nil
+		case variant.Fields != nil:
+			values, err := d.decodeFields(variant.Fields)
+			if err != nil {
+				return nil, err
+			}
+			return map[string]interface{}{variant.Name: values}, nil
+		}
+		return variant.Name, nil
+	case "type", "alias":
+		if ty.Alias == nil {
+			return nil, fmt.Errorf("alias %s has no type", name)
+		}
+		return d.decode(ty.Alias)
+	}
+	return nil, fmt.Errorf("type %s has unknown kind %s", name, ty.Kind)
+}
+
+func (d *decoder) decodePrimitive(primitive string) (interface{}, error) {
+	switch primitive {
+	case "bool":
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0] != 0, nil
+	case "u8":
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+		return b[0], nil
+	case "i8":
+		b, err := d.read(1)
+		if err != nil {
+			return nil, err
+		}
+		return int8(b[0]), nil
+	case "u16", "i16":
+		b, err := d.read(2)
+		if err != nil {
+			return nil, err
+		}
+		if primitive == "i16" {
+			return int16(binary.LittleEndian.Uint16(b)), nil
+		}
+		return binary.LittleEndian.Uint16(b), nil
+	case "u32", "i32", "f32":
+		b, err := d.read(4)
+		if err != nil {
+			return nil, err
+		}
+		v := binary.LittleEndian.Uint32(b)
+		switch primitive {
+		case "i32":
+			return int32(v), nil
+		case "f32":
+			return math.Float32frombits(v), nil
+		}
+		return v, nil
+	case "u64", "i64", "f64":
+		b, err := d.read(8)
+		if err != nil {
+			return nil, err
+		}
+		v := binary.LittleEndian.Uint64(b)
+		switch primitive {
+		case "i64":
+			return int64(v), nil
+		case "f64":
+			return math.Float64frombits(v), nil
+		}
+		return v, nil
+	case "u128", "i128":
+		b, err := d.read(16)
+		if err != nil {
+			return nil, err
+		}
+		be := make([]byte, 16)
+		for i := range b {
+			be[15-i] = b[i]
+		}
+		v := new(big.Int).SetBytes(be)
+		if primitive == "i128" && b[15]&0x80 != 0 {
+			v.Sub(v, new(big.Int).Lsh(big.NewInt(1), 128))
+		}
+		return v, nil
+	case "string":
+		n, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.read(n)
+		if err != nil {
+			return nil, err
+		}
+		return string(b), nil
+	case "bytes":
+		n, err := d.readLength()
+		if err != nil {
+			return nil, err
+		}
+		b, err := d.read(n)
+		if err != nil {
+			return nil, err
+		}
+		return append([]byte{}, b...), nil
+	case "pubkey", "publicKey":
+		b, err := d.read(32)
+		if err != nil {
+			return nil, err
+		}
+		return solana.PublicKeyFromBytes(b), nil
+	}
+	return nil, fmt.Errorf("unknown primitive %s", primitive)
+}
+
+// hasPrefix reports whether the data starts with the discriminator
+func hasPrefix(data []byte, discriminator []byte) bool {
+	return len(discriminator) > 0 && bytes.HasPrefix(data, discriminator)
+}
@@ -0,0 +1,305 @@
+package idl
+
+import (
+	"crypto/sha256"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"strings"
+	"unicode"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Idl is an anchor idl, both the legacy format (anchor < 0.30) and the current format are loaded into it
+type Idl struct {
+	Address      string         `json:"address"`
+	Name         string         `json:"name"`
+	Metadata     *Metadata      `json:"metadata"`
+	Instructions []*Instruction `json:"instructions"`
+	Events       []*Event       `json:"events"`
+	Types        []*TypeDef     `json:"types"`
+
+	types map[string]*TypeDef
+}
+
+type Metadata struct {
+	Name    string `json:"name"`
+	Address string `json:"address"`
+}
+
+type Instruction struct {
+	Name          string     `json:"name"`
+	Discriminator []byte     `json:"-"`
+	Accounts      []*Account `json:"accounts"`
+	Args          []*Field   `json:"args"`
+}
+
+// Account is an account of an instruction, or a group of accounts if Accounts is set
+type Account struct {
+	Name     string     `json:"name"`
+	Accounts []*Account `json:"accounts"`
+}
+
+type Event struct {
+	Name          string
+	Discriminator []byte
+	Fields        []*Field
+}
+
+type Field struct {
+	Name string `json:"name"`
+	Type *Type  `json:"type"`
+}
+
+type TypeDef struct {
+	Name string     `json:"name"`
+	Type *TypeDefTy `json:"type"`
+}
+
+// TypeDefTy is a struct, an enum or an alias
+type TypeDefTy struct {
+	Kind     string     `json:"kind"`
+	Fields   []*Field   `json:"-"`
+	Tuple    []*Type    `json:"-"`
+	Variants []*Variant `json:"-"`
+	Alias    *Type      `json:"alias"`
+}
+
+type Variant struct {
+	Name   string   `json:"name"`
+	Fields []*Field `json:"-"`
+	Tuple  []*Type  `json:"-"`
+}
+
+// Type is a primitive when Primitive is set, otherwise one of the compound types
+type Type struct {
+	Primitive string
+	Vec       *Type
+	Option    *Type
+	Array     *Type
+	Len       int
+	Defined   string
+}
+
+// LoadFile loads an idl json file
+func LoadFile(path string) (*Idl, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	return Load(data)
+}
+
+// Load loads an idl json
+func Load(data []byte) (*Idl, error) {
+	var raw struct {
+		Idl
+		Instructions []struct {
+			Instruction
+			Discriminator []byte `json:"discriminator"`
+		} `json:"instructions"`
+		Events []struct {
+			Name          string          `json:"name"`
+			Discriminator []byte          `json:"discriminator"`
+			Fields        json.RawMessage `json:"fields"`
+		} `json:"events"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return nil, err
+	}
+	idl := &raw.Idl
+	idl.Instructions = nil
+	idl.Events = nil
+	if idl.Metadata != nil {
+		if idl.Name == "" {
+			idl.Name = idl.Metadata.Name
+		}
+		if idl.Address == "" {
+			idl.Address = idl.Metadata.Address
+		}
+	}
+	for i := range raw.Instructions {
+		instruction := raw.Instructions[i].Instruction
+		instruction.Discriminator = raw.Instructions[i].Discriminator
+		if len(instruction.Discriminator) == 0 {
+			instruction.Discriminator = sighash("global", snakeCase(instruction.Name))
+		}
+		idl.Instructions = append(idl.Instructions, &instruction)
+	}
+	for _, item := range raw.Events {
+		event := Event{
+			Name:          item.Name,
+			Discriminator: item.Discriminator,
+		}
+		var err error
+		if event.Fields, _, err = unmarshalFields(item.Fields); err != nil {
+			return nil, err
+		}
+		if len(event.Discriminator) == 0 {
+			event.Discriminator = sighash("event", event.Name)
+		}
+		idl.Events = append(idl.Events, &event)
+	}
+	idl.types = make(map[string]*TypeDef)
+	for _, typeDef := range idl.Types {
+		if typeDef.Type == nil {
+			return nil, fmt.Errorf("type %s has no definition", typeDef.Name)
+		}
+		idl.types[typeDef.Name] = typeDef
+	}
+	// the fields of the current format events are defined in the types
+	for _, event := range idl.Events {
+		if len(event.Fields) > 0 {
+			continue
+		}
+		if typeDef, ok := idl.types[event.Name]; ok {
+			event.Fields = typeDef.Type.Fields
+		}
+	}
+	return idl, nil
+}
+
+// ProgramId is the address of the idl
+func (idl *Idl) ProgramId() (solana.PublicKey, error) {
+	if idl.Address == "" {
+		return solana.PublicKey{}, errors.New("idl has no address")
+	}
+	return solana.PublicKeyFromBase58(idl.Address)
+}
+
+func (t *TypeDefTy) UnmarshalJSON(data []byte) error {
+	var raw struct {
+		Kind     string            `json:"kind"`
+		Fields   json.RawMessage   `json:"fields"`
+		Variants []json.RawMessage `json:"variants"`
+		Alias    *Type             `json:"alias"`
+		Value    *Type             `json:"value"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	t.Kind = raw.Kind
+	t.Alias = raw.Alias
+	if t.Alias == nil {
+		t.Alias = raw.Value
+	}
+	var err error
+	if t.Fields, t.Tuple, err = unmarshalFields(raw.Fields); err != nil {
+		return err
+	}
+	for _, item := range raw.Variants {
+		var variant struct {
+			Name   string          `json:"name"`
+			Fields json.RawMessage `json:"fields"`
+		}
+		if err := json.Unmarshal(item, &variant); err != nil {
+			return err
+		}
+		v := &Variant{Name: variant.Name}
+		if v.Fields, v.Tuple, err = unmarshalFields(variant.Fields); err != nil {
+			return err
+		}
+		t.Variants = append(t.Variants, v)
+	}
+	return nil
+}
+
+// unmarshalFields reads named fields, or the types of a tuple
+func unmarshalFields(data json.RawMessage) ([]*Field, []*Type, error) {
+	if len(data) == 0 || string(data) == "null" {
+		return nil, nil, nil
+	}
+	var items []json.RawMessage
+	if err := json.Unmarshal(data, &items); err != nil {
+		return nil, nil, err
+	}
+	fields := make([]*Field, 0)
+	tuple := make([]*Type, 0)
+	for _, item := range items {
+		var field Field
+		if err := json.Unmarshal(item, &field); err == nil && field.Name != "" && field.Type != nil {
+			fields = append(fields, &field)
+			continue
+		}
+		var t Type
+		if err := json.Unmarshal(item, &t); err != nil {
+			return nil, nil, err
+		}
+		tuple = append(tuple, &t)
+	}
+	if len(tuple) > 0 {
+		return nil, tuple, nil
+	}
+	return fields, nil, nil
+}
+
+func (t *Type) UnmarshalJSON(data []byte) error {
+	var primitive string
+	if err := json.Unmarshal(data, &primitive); err == nil {
+		t.Primitive = primitive
+		return nil
+	}
+	var raw struct {
+		Vec     *Type             `json:"vec"`
+		Option  *Type             `json:"option"`
+		COption *Type             `json:"coption"`
+		Array   []json.RawMessage `json:"array"`
+		Defined json.RawMessage   `json:"defined"`
+	}
+	if err := json.Unmarshal(data, &raw); err != nil {
+		return err
+	}
+	switch {
+	case raw.Vec != nil:
+		t.Vec = raw.Vec
+	case raw.Option != nil:
+		t.Option = raw.Option
+	case raw.COption != nil:
+		t.Option = raw.COption
+	case len(raw.Array) == 2:
+		t.Array = &Type{}
+		if err := json.Unmarshal(raw.Array[0], t.Array); err != nil {
+			return err
+		}
+		if err := json.Unmarshal(raw.Array[1], &t.Len); err != nil {
+			return fmt.Errorf("array length: %w", err)
+		}
+	case len(raw.Defined) > 0:
+		// legacy: "defined": "Name", current: "defined": {"name": "Name"}
+		if err := json.Unmarshal(raw.Defined, &t.Defined); err != nil {
+			var defined struct {
+				Name string `json:"name"`
+			}
+			if err := json.Unmarshal(raw.Defined, &defined); err != nil {
+				return err
+			}
+			t.Defined = defined.Name
+		}
+	default:
+		return fmt.Errorf("unknown idl type %s", string(data))
+	}
+	return nil
+}
+
+// sighash is the first 8 bytes of sha256("<namespace>:<name>")
+func sighash(namespace string, name string) []byte {
+	hash := sha256.Sum256([]byte(namespace + ":" + name))
+	return hash[:8]
+}
+
+// snakeCase converts the camel case names of the legacy idl
+func snakeCase(name string) string {
+	var builder strings.Builder
+	for i, r := range name {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				builder.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		builder.WriteRune(r)
+	}
+	return builder.String()
+}
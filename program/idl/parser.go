@@ -0,0 +1,109 @@
+package idl
+
+import (
+	"fmt"
+
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+)
+
+// DecodeInstruction decodes the data & accounts of an instruction of the idl program
+func (idl *Idl) DecodeInstruction(programId solana.PublicKey, accounts []*solana.AccountMeta, data []byte) (*types.DecodedInstruction, error) {
+	for _, instruction := range idl.Instructions {
+		if !hasPrefix(data, instruction.Discriminator) {
+			continue
+		}
+		d := &decoder{idl: idl, data: data, pos: len(instruction.Discriminator)}
+		args, err := d.decodeFields(instruction.Args)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", instruction.Name, err)
+		}
+		decoded := &types.DecodedInstruction{
+			ProgramId: programId,
+			Name:      instruction.Name,
+			Args:      args,
+			Accounts:  make(map[string]solana.PublicKey),
+		}
+		// optional accounts at the end may be missing, remaining accounts are not named
+		names := flattenAccounts(instruction.Accounts, "", nil)
+		for i, name := range names {
+			if i >= len(accounts) {
+				break
+			}
+			decoded.Accounts[name] = accounts[i].PublicKey
+		}
+		return decoded, nil
+	}
+	return nil, program.ErrParserNotFound
+}
+
+// DecodeEvent decodes the data of an event of the idl program, the data starts after the discriminator
+func (idl *Idl) DecodeEvent(programId solana.PublicKey, event *Event, data []byte) (*types.DecodedEvent, error) {
+	d := &decoder{idl: idl, data: data}
+	fields, err := d.decodeFields(event.Fields)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", event.Name, err)
+	}
+	return &types.DecodedEvent{
+		ProgramId: programId,
+		Name:      event.Name,
+		Fields:    fields,
+	}, nil
+}
+
+// Parser is the program parser of the idl, the decoded instruction is the ParsedInstruction
+func (idl *Idl) Parser() program.Parser {
+	return func(in *types.Instruction, meta *types.Meta) error {
+		decoded, err := idl.DecodeInstruction(in.RawInstruction.ProgID, in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
+		if err != nil {
+			return err
+		}
+		in.ParsedInstruction = decoded
+		return nil
+	}
+}
+
+// Register registers the parser & the anchor events of the idl for its address
+func Register(registry *program.Registry, idl *Idl, t string, priority int) error {
+	programId, err := idl.ProgramId()
+	if err != nil {
+		return err
+	}
+	registry.Register(programId, idl.Name, t, priority, idl.Parser())
+	for _, event := range idl.Events {
+		if len(event.Discriminator) != 8 {
+			continue
+		}
+		var discriminator [8]byte
+		copy(discriminator[:], event.Discriminator)
+		event := event
+		registry.RegisterEvent(programId, discriminator, func(programId solana.PublicKey, data []byte) (types.Event, error) {
+			return idl.DecodeEvent(programId, event, data)
+		})
+	}
+	return nil
+}
+
+// RegisterFile loads an idl json file and registers it
+func RegisterFile(registry *program.Registry, path string, t string, priority int) (*Idl, error) {
+	idl, err := LoadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	if err := Register(registry, idl, t, priority); err != nil {
+		return nil, err
+	}
+	return idl, nil
+}
+
+func flattenAccounts(accounts []*Account, prefix string, names []string) []string {
+	for _, account := range accounts {
+		if len(account.Accounts) > 0 {
+			names = flattenAccounts(account.Accounts, prefix+account.Name+".", names)
+			continue
+		}
+		names = append(names, prefix+account.Name)
+	}
+	return names
+}
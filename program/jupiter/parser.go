@@ -1,13 +1,13 @@
 package jupiter
 
 import (
-	"bytes"
 	"errors"
 	"github.com/blockchain-develop/solana-parser/program"
 
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/types"
 	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/jupiter"
 )
 
@@ -21,33 +21,28 @@ func RegisterParser(id uint64, p Parser) {
 	Parsers[id] = p
 }
 
-var (
-	Instruction_AnchorSelfCPILog = ag_binary.TypeID([8]byte{228, 69, 165, 46, 81, 203, 154, 29})
-)
-
 func init() {
-	program.RegisterParser(jupiter.ProgramID, jupiter.ProgramName, program.Swap, 1, ProgramParser)
+	program.RegisterParser(jupiter.ProgramID, jupiter.ProgramName, program.Swap, 2, ProgramParser)
 	RegisterParser(uint64(jupiter.Instruction_Route.Uint32()), ParseSwap)
+	program.RegisterEvent(jupiter.ProgramID, jupiter.SwapEventEventDataDiscriminator, DecodeSwapEvent)
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
 	typeID, _ := dec.ReadTypeID()
-	// 特殊的，处理log
-	if typeID == Instruction_AnchorSelfCPILog {
-		return ParseSwapLog(nil, in, meta)
-	}
-	if typeID != jupiter.Instruction_Route {
-		return nil
+	// the instructions without parser are not decoded, the bindings do not know all of them
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
 	}
 	inst, err := jupiter.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -60,59 +55,65 @@ func ParseSwap(inst *jupiter.Instruction, in *types.Instruction, meta *types.Met
 		User:   inst1.GetUserTransferAuthorityAccount().PublicKey,
 	}
 	stepSize := len(*inst1.RoutePlan)
-	if len(in.Children) != stepSize*2 {
+	// the inner instructions of a failed transaction are missing or partial
+	failed := len(meta.ErrorMessage) > 0 || in.Root().Failed
+	if len(in.Children) != stepSize*2 && !failed {
 		err := errors.New("jupiter route is invalid")
 		log.Logger.Error("jupiter", "error", err)
 		return err
 	}
+	// swap events are decoded from the self cpi logs into the receipt
+	swapEvents := make([]*types.SwapEvent, 0, stepSize)
+	for _, receipt := range in.Receipt {
+		if swapEvent, ok := receipt.(*types.SwapEvent); ok {
+			swapEvents = append(swapEvents, swapEvent)
+		}
+	}
 	for i := 0; i < stepSize; i++ {
-		swapIn := in.Children[i*2]
-		eventIn := in.Children[i*2+1]
+		var dex solana.PublicKey
 		var swap *types.Swap
-		if len(swapIn.Event) == 1 {
-			swap = swapIn.Event[0].(*types.Swap)
-		} else {
+		if i*2 < len(in.Children) {
+			swapIn := in.Children[i*2]
+			dex = swapIn.RawInstruction.ProgID
+			if len(swapIn.Event) == 1 {
+				swap, _ = swapIn.Event[0].(*types.Swap)
+			}
+		}
+		if swap == nil && !failed {
 			log.Logger.Error("jupiter swap instruction is unknown")
 		}
 		routePlan := &types.RoutePlan{}
 		var swapEvent *types.SwapEvent
-		if len(eventIn.Event) == 1 {
-			swapEvent = eventIn.Event[0].(*types.SwapEvent)
-		} else {
+		if i < len(swapEvents) {
+			swapEvent = swapEvents[i]
+		} else if !failed {
 			log.Logger.Error("jupiter swap event is unknown")
 		}
 		route.RouteSteps = append(route.RouteSteps, &types.RouteStep{
-			Dex:       swapIn.RawInstruction.ProgID,
+			Dex:       dex,
 			Swap:      swap,
 			RoutePlan: routePlan,
 			SwapEvent: swapEvent,
 		})
 	}
-	in.Event = []interface{}{route}
+	in.Event = []types.Event{route}
 	return nil
 }
 
-func ParseSwapLog(inst *jupiter.Instruction, in *types.Instruction, meta *types.Meta) error {
-	data := in.RawInstruction.DataBytes
-	dec := ag_binary.NewBorshDecoder(data)
-	instId, _ := dec.ReadBytes(8)
-	eventId, _ := dec.ReadBytes(8)
-	if !bytes.Equal(instId, Instruction_AnchorSelfCPILog[:]) || !bytes.Equal(eventId, jupiter.SwapEventEventDataDiscriminator[:]) {
-		return nil
-	}
+// SwapEvent
+func DecodeSwapEvent(programId solana.PublicKey, data []byte) (types.Event, error) {
 	var swapEvent jupiter.SwapEvent
-	if err := dec.Decode(&swapEvent); err != nil {
-		return err
+	if err := ag_binary.NewBorshDecoder(data).Decode(&swapEvent); err != nil {
+		return nil, err
 	}
-	mySwapEvent := types.SwapEvent{
+	return &types.SwapEvent{
+		ProgramId:    programId,
 		Amm:          swapEvent.Amm,
 		InputMint:    swapEvent.InputMint,
 		InputAmount:  swapEvent.InputAmount,
 		OutputMint:   swapEvent.OutputMint,
 		OutputAmount: swapEvent.OutputAmount,
-	}
-	in.Event = []interface{}{&mySwapEvent}
-	return nil
+	}, nil
 }
 
 // Default
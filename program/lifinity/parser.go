@@ -1,8 +1,6 @@
 package lifinity
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
@@ -38,14 +36,18 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	if typeID == Instruction_UpdateTargetPriceBufferParam || typeID == Instruction_UpdateConfigSpreadParam {
 		return nil
 	}
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := lifinity_v2.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -53,14 +55,16 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 // Swap
 func ParseSwap(inst *lifinity_v2.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*lifinity_v2.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetAmmAccount().PublicKey,
 		User: inst1.GetAuthorityAccount().PublicKey,
 	}
-	in.Event = []interface{}{swap}
-	log.Logger.Info("ignore parse swap", "program", lifinity_v2.ProgramName)
-	return nil
+	swap.InputTransfer = matcher.ToAccount(meta, inst1.GetSwapSourceAccount().PublicKey)
+	swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetSwapDestinationAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 
 func ParseDepositAllTokenTypes(inst *lifinity_v2.Instruction, in *types.Instruction, meta *types.Meta) error {
@@ -0,0 +1,94 @@
+package program
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+)
+
+var ErrAmbiguousTransfer = errors.New("ambiguous transfer")
+
+// TransferMatcher matches the transfers of the children of an instruction for a parser,
+// the matches with more than one candidate & the unknown mints are returned by Err so that they are reported as diagnostics
+type TransferMatcher struct {
+	in  *types.Instruction
+	err error
+}
+
+func NewTransferMatcher(in *types.Instruction) *TransferMatcher {
+	return &TransferMatcher{in: in}
+}
+
+// Match returns the only transfer matching any of the matches, nil if none or more than one match
+func (m *TransferMatcher) Match(matches ...types.TransferMatch) *types.Transfer {
+	candidates := m.in.MatchTransfers(matches...)
+	if len(candidates) == 0 {
+		return nil
+	}
+	if len(candidates) > 1 {
+		m.err = errors.Join(m.err, fmt.Errorf("%w: %d candidates for %s", ErrAmbiguousTransfer, len(candidates), describeMatches(matches)))
+		return nil
+	}
+	return candidates[0]
+}
+
+// describeMatches lists the matches of one lookup, e.g. "in <account> <mint> or out <account> any mint"
+func describeMatches(matches []types.TransferMatch) string {
+	parts := make([]string, 0, len(matches))
+	for _, match := range matches {
+		mint := match.Mint.String()
+		if match.AnyMint {
+			mint = "any mint"
+		}
+		parts = append(parts, fmt.Sprintf("%s %s %s", match.Direction, match.Account, mint))
+	}
+	return strings.Join(parts, " or ")
+}
+
+// To matches the transfer of the mint into the account
+func (m *TransferMatcher) To(account solana.PublicKey, mint solana.PublicKey) *types.Transfer {
+	return m.Match(types.TransferMatch{Account: account, Mint: mint, Direction: types.TransferIn})
+}
+
+// From matches the transfer of the mint out of the account
+func (m *TransferMatcher) From(account solana.PublicKey, mint solana.PublicKey) *types.Transfer {
+	return m.Match(types.TransferMatch{Account: account, Mint: mint, Direction: types.TransferOut})
+}
+
+// ToAccount matches the transfer into the token account of the mint of the account, nil if the mint is unknown
+func (m *TransferMatcher) ToAccount(meta *types.Meta, account solana.PublicKey) *types.Transfer {
+	mint, ok := m.AccountMint(meta, account)
+	if !ok {
+		return nil
+	}
+	return m.To(account, mint)
+}
+
+// FromAccount matches the transfer out of the token account of the mint of the account, nil if the mint is unknown
+func (m *TransferMatcher) FromAccount(meta *types.Meta, account solana.PublicKey) *types.Transfer {
+	mint, ok := m.AccountMint(meta, account)
+	if !ok {
+		return nil
+	}
+	return m.From(account, mint)
+}
+
+// AccountMint is the mint of a token account from the token balances of the transaction,
+// for the parsers whose instruction has no mint account, an unknown mint is returned by Err
+func (m *TransferMatcher) AccountMint(meta *types.Meta, account solana.PublicKey) (solana.PublicKey, bool) {
+	if meta != nil {
+		if tokenAccount, ok := meta.TokenAccounts[account]; ok {
+			return tokenAccount.Mint, true
+		}
+	}
+	m.err = errors.Join(m.err, fmt.Errorf("%w: %s", ErrTokenAccountNotFound, account))
+	return solana.PublicKey{}, false
+}
+
+// Err is the ambiguous matches, nil if every match had at most one candidate
+func (m *TransferMatcher) Err() error {
+	return m.err
+}
@@ -1,13 +1,14 @@
 package meteora_dlmm
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/anchor"
 	"github.com/blockchain-develop/solana-parser/types"
 	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/meteora_dlmm"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -21,9 +22,24 @@ func RegisterParser(id uint64, p Parser) {
 }
 
 var (
-	Instruction_AnchorSelfCPILog = ag_binary.TypeID([8]byte{228, 69, 165, 46, 81, 203, 154, 29})
+	Event_Swap = anchor.EventDiscriminator("Swap")
 )
 
+// SwapEvent is the Swap event of the lb clmm idl, the bindings do not generate events
+type SwapEvent struct {
+	LbPair      solana.PublicKey
+	From        solana.PublicKey
+	StartBinId  int32
+	EndBinId    int32
+	AmountIn    uint64
+	AmountOut   uint64
+	SwapForY    bool
+	Fee         uint64
+	ProtocolFee uint64
+	FeeBps      ag_binary.Uint128
+	HostFee     uint64
+}
+
 func init() {
 	program.RegisterParser(meteora_dlmm.ProgramID, meteora_dlmm.ProgramName, program.Swap, 1, ProgramParser)
 	RegisterParser(uint64(meteora_dlmm.Instruction_InitializeLbPair.Uint32()), ParseInitializeLbPair)
@@ -68,22 +84,24 @@ func init() {
 	RegisterParser(uint64(meteora_dlmm.Instruction_GoToABin.Uint32()), ParseGoToABin)
 	RegisterParser(uint64(meteora_dlmm.Instruction_SetPreActivationDuration.Uint32()), ParseSetPreActivationDuration)
 	RegisterParser(uint64(meteora_dlmm.Instruction_SetPreActivationSwapAddress.Uint32()), ParseSetPreActivationSwapAddress)
+	program.RegisterEvent(meteora_dlmm.ProgramID, Event_Swap, DecodeSwapEvent)
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
-	typeID, err := dec.ReadTypeID()
-	if typeID == Instruction_AnchorSelfCPILog {
-		return nil
+	typeID, _ := dec.ReadTypeID()
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
 	}
 	inst, err := meteora_dlmm.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -108,39 +126,42 @@ func ParseInitializeBinArray(inst *meteora_dlmm.Instruction, in *types.Instructi
 }
 func ParseAddLiquidity(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_dlmm.AddLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetLbPairAccount().PublicKey,
 		User: inst1.GetSenderAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetReserveXAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetReserveYAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetReserveXAccount().PublicKey, inst1.GetTokenXMintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetReserveYAccount().PublicKey, inst1.GetTokenYMintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseAddLiquidityByWeight(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_dlmm.AddLiquidityByWeight)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetLbPairAccount().PublicKey,
 		User: inst1.GetSenderAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetReserveXAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetReserveYAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetReserveXAccount().PublicKey, inst1.GetTokenXMintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetReserveYAccount().PublicKey, inst1.GetTokenYMintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseAddLiquidityByStrategy(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_dlmm.AddLiquidityByStrategy)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetLbPairAccount().PublicKey,
 		User: inst1.GetSenderAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetReserveXAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetReserveYAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetReserveXAccount().PublicKey, inst1.GetTokenXMintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetReserveYAccount().PublicKey, inst1.GetTokenYMintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseAddLiquidityByStrategyOneSide(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	log.Logger.Info("ignore parse add liquidity by strategy one-side", "program", meteora_dlmm.ProgramName)
@@ -152,15 +173,16 @@ func ParseAddLiquidityOneSide(inst *meteora_dlmm.Instruction, in *types.Instruct
 }
 func ParseRemoveLiquidity(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_dlmm.RemoveLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetLbPairAccount().PublicKey,
 		User: inst1.GetSenderAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetReserveXAccount().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetReserveYAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.From(inst1.GetReserveXAccount().PublicKey, inst1.GetTokenXMintAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.From(inst1.GetReserveYAccount().PublicKey, inst1.GetTokenYMintAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 func ParseInitializePosition(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	// only create accounts
@@ -177,27 +199,29 @@ func ParseUpdatePositionOperator(inst *meteora_dlmm.Instruction, in *types.Instr
 }
 func ParseSwap(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_dlmm.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetLbPairAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByFrom(inst1.GetUserTokenInAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByTo(inst1.GetUserTokenOutAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.FromAccount(meta, inst1.GetUserTokenInAccount().PublicKey)
+	swap.OutputTransfer = matcher.ToAccount(meta, inst1.GetUserTokenOutAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSwapExactOut(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_dlmm.SwapExactOut)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetLbPairAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByFrom(inst1.GetUserTokenInAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByTo(inst1.GetUserTokenOutAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.FromAccount(meta, inst1.GetUserTokenInAccount().PublicKey)
+	swap.OutputTransfer = matcher.ToAccount(meta, inst1.GetUserTokenOutAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSwapWithPriceImpact(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	log.Logger.Info("ignore parse swap with price impact", "program", meteora_dlmm.ProgramName)
@@ -263,15 +287,16 @@ func ParseSetActivationPoint(inst *meteora_dlmm.Instruction, in *types.Instructi
 }
 func ParseRemoveLiquidityByRange(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_dlmm.RemoveLiquidityByRange)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetLbPairAccount().PublicKey,
 		User: inst1.GetSenderAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetReserveXAccount().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetReserveYAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.From(inst1.GetReserveXAccount().PublicKey, inst1.GetTokenXMintAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.From(inst1.GetReserveYAccount().PublicKey, inst1.GetTokenYMintAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 func ParseAddLiquidityOneSidePrecise(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	log.Logger.Info("ignore parse add liquidity one-side precise", "program", meteora_dlmm.ProgramName)
@@ -296,3 +321,25 @@ func ParseDefault(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *t
 func ParseFault(inst *meteora_dlmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	panic("not supported")
 }
+
+// Swap event
+func DecodeSwapEvent(programId solana.PublicKey, data []byte) (types.Event, error) {
+	var swapEvent SwapEvent
+	if err := ag_binary.NewBorshDecoder(data).Decode(&swapEvent); err != nil {
+		return nil, err
+	}
+	return &types.DlmmSwapEvent{
+		ProgramId:   programId,
+		LbPair:      swapEvent.LbPair,
+		From:        swapEvent.From,
+		StartBinId:  swapEvent.StartBinId,
+		EndBinId:    swapEvent.EndBinId,
+		AmountIn:    swapEvent.AmountIn,
+		AmountOut:   swapEvent.AmountOut,
+		SwapForY:    swapEvent.SwapForY,
+		Fee:         swapEvent.Fee,
+		ProtocolFee: swapEvent.ProtocolFee,
+		FeeBps:      decimal.NewFromBigInt(swapEvent.FeeBps.BigInt(), 0),
+		HostFee:     swapEvent.HostFee,
+	}, nil
+}
@@ -1,11 +1,10 @@
 package meteora_pools
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go/programs/meteora_pools"
 )
 
@@ -50,14 +49,20 @@ func init() {
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
+	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
+	typeID, _ := dec.ReadTypeID()
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := meteora_pools.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -83,15 +88,16 @@ func ParseEnableOrDisablePool(inst *meteora_pools.Instruction, in *types.Instruc
 
 func ParseSwap(inst *meteora_pools.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_pools.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByFrom(inst1.GetUserSourceTokenAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByTo(inst1.GetUserDestinationTokenAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.FromAccount(meta, inst1.GetUserSourceTokenAccount().PublicKey)
+	swap.OutputTransfer = matcher.ToAccount(meta, inst1.GetUserDestinationTokenAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 
 func ParseRemoveLiquiditySingleSide(inst *meteora_pools.Instruction, in *types.Instruction, meta *types.Meta) error {
@@ -102,41 +108,44 @@ func ParseRemoveLiquiditySingleSide(inst *meteora_pools.Instruction, in *types.I
 func ParseAddImbalanceLiquidity(inst *meteora_pools.Instruction, in *types.Instruction, meta *types.Meta) error {
 	// log.Logger.Info("ignore parse add imbalance liquidity", "program", meteora_pools.ProgramName)
 	inst1 := inst.Impl.(*meteora_pools.AddImbalanceLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetATokenVaultAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetBTokenVaultAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.ToAccount(meta, inst1.GetATokenVaultAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.ToAccount(meta, inst1.GetBTokenVaultAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 
 func ParseRemoveBalanceLiquidity(inst *meteora_pools.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_pools.RemoveBalanceLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetATokenVaultAccount().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetBTokenVaultAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.FromAccount(meta, inst1.GetATokenVaultAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.FromAccount(meta, inst1.GetBTokenVaultAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 
 func ParseAddBalanceLiquidity(inst *meteora_pools.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*meteora_pools.AddBalanceLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetATokenVaultAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetBTokenVaultAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.ToAccount(meta, inst1.GetATokenVaultAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.ToAccount(meta, inst1.GetBTokenVaultAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 
 func ParseSetPoolFees(inst *meteora_pools.Instruction, in *types.Instruction, meta *types.Meta) error {
@@ -189,15 +198,16 @@ func ParseInitializePermissionlessConstantProductPoolWithConfig2(inst *meteora_p
 	// todo, add liquidity
 	// find two deposit
 	inst1 := inst.Impl.(*meteora_pools.InitializePermissionlessConstantProductPoolWithConfig2)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetPayerAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetATokenVaultAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetBTokenVaultAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetATokenVaultAccount().PublicKey, inst1.GetTokenAMintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetBTokenVaultAccount().PublicKey, inst1.GetTokenBMintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 
 func ParseInitializeCustomizablePermissionlessConstantProductPool(inst *meteora_pools.Instruction, in *types.Instruction, meta *types.Meta) error {
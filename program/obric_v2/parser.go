@@ -1,7 +1,6 @@
 package obricv2
 
 import (
-	"errors"
 	"github.com/blockchain-develop/solana-parser/program"
 
 	"github.com/blockchain-develop/solana-parser/types"
@@ -46,14 +45,18 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	if typeID == Instruction_UpdateTargetPriceBufferParam || typeID == Instruction_UpdateConfigSpreadParam {
 		return nil
 	}
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := obric_v2.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -61,46 +64,49 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 // Swap
 func ParseSwap(inst *obric_v2.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*obric_v2.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetTradingPairAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
 	if *inst1.IsXToY {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetReserveXAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetReserveYAccount().PublicKey)
+		swap.InputTransfer = matcher.To(inst1.GetReserveXAccount().PublicKey, inst1.GetMintXAccount().PublicKey)
+		swap.OutputTransfer = matcher.From(inst1.GetReserveYAccount().PublicKey, inst1.GetMintYAccount().PublicKey)
 	} else {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetReserveYAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetReserveXAccount().PublicKey)
+		swap.InputTransfer = matcher.To(inst1.GetReserveYAccount().PublicKey, inst1.GetMintYAccount().PublicKey)
+		swap.OutputTransfer = matcher.From(inst1.GetReserveXAccount().PublicKey, inst1.GetMintXAccount().PublicKey)
 	}
-	in.Event = []interface{}{swap}
-	return nil
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 
 func ParseSwapXToY(inst *obric_v2.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*obric_v2.SwapXToY)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetTradingPairAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetReserveXAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetReserveYAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.To(inst1.GetReserveXAccount().PublicKey, inst1.GetMintXAccount().PublicKey)
+	swap.OutputTransfer = matcher.From(inst1.GetReserveYAccount().PublicKey, inst1.GetMintYAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 
 func ParseSwapYToX(inst *obric_v2.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*obric_v2.SwapYToX)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetTradingPairAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetReserveYAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetReserveXAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.To(inst1.GetReserveYAccount().PublicKey, inst1.GetMintYAccount().PublicKey)
+	swap.OutputTransfer = matcher.From(inst1.GetReserveXAccount().PublicKey, inst1.GetMintXAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 
 // Default
@@ -5,14 +5,6 @@ import (
 	"github.com/gagliardetto/solana-go"
 )
 
-var (
-	Parsers     = make(map[solana.PublicKey]Parser)
-	Name2Id     = make(map[string]solana.PublicKey)
-	Id2Name     = make(map[solana.PublicKey]string)
-	Id2Type     = make(map[solana.PublicKey]string)
-	Id2Priority = make(map[solana.PublicKey]int)
-)
-
 const (
 	Token      = "Token"
 	Swap       = "Swap"
@@ -22,16 +14,20 @@ const (
 
 type Parser func(in *types.Instruction, meta *types.Meta) error
 
+// RegisterParser registers the parser of a program into the DefaultRegistry,
+// the priority ranks nested programs when picking the primary actions: 0 for token programs, 1 for dexes, 2 for routers
 func RegisterParser(program solana.PublicKey, name string, t string, priority int, p Parser) {
-	Parsers[program] = p
-	Id2Priority[program] = priority
-	Name2Id[name] = program
-	Id2Name[program] = name
-	Id2Type[program] = t
+	DefaultRegistry.Register(program, name, t, priority, p)
 }
 
+// RegisterEvent registers the decoder of one anchor event of a program into the DefaultRegistry
+func RegisterEvent(program solana.PublicKey, discriminator [8]byte, d EventDecoder) {
+	DefaultRegistry.RegisterEvent(program, discriminator, d)
+}
+
+// RemoveParser removes the parser of a program from the DefaultRegistry
 func RemoveParser(program solana.PublicKey) {
-	Parsers[program] = nil
+	DefaultRegistry.Unregister(program)
 }
 
 func FilterInstruction(in *solana.CompiledInstruction, meta *types.Meta) *types.Instruction {
@@ -49,11 +45,7 @@ func FilterInstruction(in *solana.CompiledInstruction, meta *types.Meta) *types.
 	}
 }
 
+// Parse runs the parser of the instruction program registered in the DefaultRegistry
 func Parse(in *types.Instruction, meta *types.Meta) error {
-	programId := in.RawInstruction.ProgID
-	parser, ok := Parsers[programId]
-	if !ok {
-		return nil
-	}
-	return parser(in, meta)
+	return DefaultRegistry.Parse(in, meta)
 }
@@ -1,11 +1,11 @@
 package phoenix_v1
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/phoenix_v1"
 )
 
@@ -52,39 +52,47 @@ func init() {
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
+	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
+	typeID, _ := dec.ReadUint8()
+	if _, ok := Parsers[uint64(typeID)]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := phoenix_v1.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
 
 func ParseSwap(inst *phoenix_v1.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*phoenix_v1.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetMarketAccount().PublicKey,
 		User: inst1.GetTraderAccount().PublicKey,
 	}
-	if transfer := in.FindChildTransferByTo(inst1.GetBaseVaultAccount().PublicKey); transfer != nil {
-		swap.InputTransfer = transfer
-	}
-	if transfer := in.FindChildTransferByTo(inst1.GetQuoteVaultAccount().PublicKey); transfer != nil {
-		swap.InputTransfer = transfer
+	// the input goes into one vault and the output comes out of the other one, whatever the side of the order
+	baseVault := inst1.GetBaseVaultAccount().PublicKey
+	quoteVault := inst1.GetQuoteVaultAccount().PublicKey
+	inputs := make([]types.TransferMatch, 0, 2)
+	outputs := make([]types.TransferMatch, 0, 2)
+	for _, vault := range []solana.PublicKey{baseVault, quoteVault} {
+		if mint, ok := matcher.AccountMint(meta, vault); ok {
+			inputs = append(inputs, types.TransferMatch{Account: vault, Mint: mint, Direction: types.TransferIn})
+			outputs = append(outputs, types.TransferMatch{Account: vault, Mint: mint, Direction: types.TransferOut})
+		}
 	}
-	if transfer := in.FindChildTransferByFrom(inst1.GetBaseVaultAccount().PublicKey); transfer != nil {
-		swap.OutputTransfer = transfer
-	}
-	if transfer := in.FindChildTransferByFrom(inst1.GetQuoteVaultAccount().PublicKey); transfer != nil {
-		swap.OutputTransfer = transfer
-	}
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.Match(inputs...)
+	swap.OutputTransfer = matcher.Match(outputs...)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSwapWithFreeFunds(inst *phoenix_v1.Instruction, in *types.Instruction, meta *types.Meta) error {
 	log.Logger.Info("ignore parse swap", "program", phoenix_v1.ProgramName)
@@ -1,13 +1,12 @@
 package pump
 
 import (
-	"bytes"
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/anchor"
 	"github.com/blockchain-develop/solana-parser/types"
 	ag_binary "github.com/gagliardetto/binary"
+	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/pumpfun"
 )
 
@@ -22,9 +21,8 @@ func RegisterParser(id uint64, p Parser) {
 }
 
 var (
-	Instruction_AnchorSelfCPILog = ag_binary.TypeID([8]byte{228, 69, 165, 46, 81, 203, 154, 29})
-	Event_Create                 = [8]byte{0x1b, 0x72, 0xa9, 0x4d, 0xde, 0xeb, 0x63, 0x76}
-	Event_Swap                   = [8]byte{0xbd, 0xdb, 0x7f, 0xd3, 0x4e, 0xe6, 0x61, 0xee}
+	Event_Create = anchor.EventDiscriminator("CreateEvent")
+	Event_Swap   = anchor.EventDiscriminator("TradeEvent")
 )
 
 func init() {
@@ -35,22 +33,25 @@ func init() {
 	RegisterParser(uint64(pumpfun.Instruction_Sell.Uint32()), ParseSell)
 	RegisterParser(uint64(pumpfun.Instruction_Withdraw.Uint32()), ParseWithdraw)
 	RegisterParser(uint64(pumpfun.Instruction_SetParams.Uint32()), ParseDefault)
+	program.RegisterEvent(pumpfun.ProgramID, Event_Create, DecodeCreateEvent)
+	program.RegisterEvent(pumpfun.ProgramID, Event_Swap, DecodeTradeEvent)
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
 	typeID, _ := dec.ReadTypeID()
-	if typeID == Instruction_AnchorSelfCPILog {
-		return nil
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
 	}
 	inst, err := pumpfun.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -65,7 +66,6 @@ func ParseInitialize(inst *pumpfun.Instruction, in *types.Instruction, meta *typ
 func ParseCreate(inst *pumpfun.Instruction, in *types.Instruction, meta *types.Meta) error {
 	//log.Logger.Info("ignore parse create", "program", pumpfun.ProgramName)
 	inst1 := inst.Impl.(*pumpfun.Create)
-	in.ParsedInstruction = inst1
 	memeMint := &types.MemeCreate{
 		Dex:                    in.RawInstruction.ProgID,
 		Mint:                   inst1.GetMintAccount().PublicKey,
@@ -74,33 +74,7 @@ func ParseCreate(inst *pumpfun.Instruction, in *types.Instruction, meta *types.M
 		AssociatedBondingCurve: inst1.GetAssociatedBondingCurveAccount().PublicKey,
 	}
 	memeMint.MintTo = in.FindChildMintToByTo(inst1.GetAssociatedBondingCurveAccount().PublicKey)
-	in.Event = []interface{}{memeMint}
-
-	myLog := in.FindChildByProgram(pumpfun.ProgramID)
-	if myLog == nil {
-		return nil
-	}
-	data := myLog.RawInstruction.DataBytes
-	dec := ag_binary.NewBorshDecoder(data)
-	instId, _ := dec.ReadBytes(8)
-	eventId, _ := dec.ReadBytes(8)
-	if !bytes.Equal(instId, Instruction_AnchorSelfCPILog[:]) || !bytes.Equal(eventId, Event_Create[:]) {
-		return nil
-	}
-	var createEvent pumpfun.CreateEvent
-	if err := dec.Decode(&createEvent); err != nil {
-		return err
-	}
-	memeCreateEvent := types.MemeCreateEvent{
-		Name:         createEvent.Name,
-		Symbol:       createEvent.Symbol,
-		Uri:          createEvent.Uri,
-		Mint:         createEvent.Mint,
-		BondingCurve: createEvent.BondingCurve,
-		User:         createEvent.User,
-	}
-	in.Receipt = []interface{}{&memeCreateEvent}
-
+	in.Event = []types.Event{memeMint}
 	return nil
 }
 
@@ -108,7 +82,7 @@ func ParseCreate(inst *pumpfun.Instruction, in *types.Instruction, meta *types.M
 func ParseBuy(inst *pumpfun.Instruction, in *types.Instruction, meta *types.Meta) error {
 	//log.Logger.Info("ignore parse buy", "program", pumpfun.ProgramName)
 	inst1 := inst.Impl.(*pumpfun.Buy)
-	in.ParsedInstruction = inst1
+	matcher := program.NewTransferMatcher(in)
 	memeBuy := &types.MemeBuy{
 		Dex:                    in.RawInstruction.ProgID,
 		Mint:                   inst1.GetMintAccount().PublicKey,
@@ -116,45 +90,23 @@ func ParseBuy(inst *pumpfun.Instruction, in *types.Instruction, meta *types.Meta
 		BondingCurve:           inst1.GetBondingCurveAccount().PublicKey,
 		AssociatedBondingCurve: inst1.GetAssociatedBondingCurveAccount().PublicKey,
 	}
-	memeBuy.SolTransfer = in.FindChildTransferByTo(inst1.GetBondingCurveAccount().PublicKey)
-	memeBuy.FeeTransfer = in.FindChildTransferByTo(inst1.GetFeeRecipientAccount().PublicKey)
-	memeBuy.MintTransfer = in.FindChildTransferByFrom(inst1.GetAssociatedBondingCurveAccount().PublicKey)
-	in.Event = []interface{}{memeBuy}
-
-	myLog := in.FindChildByProgram(pumpfun.ProgramID)
-	if myLog == nil {
-		return nil
-	}
-	data := myLog.RawInstruction.DataBytes
-	dec := ag_binary.NewBorshDecoder(data)
-	instId, _ := dec.ReadBytes(8)
-	eventId, _ := dec.ReadBytes(8)
-	if !bytes.Equal(instId, Instruction_AnchorSelfCPILog[:]) || !bytes.Equal(eventId, Event_Swap[:]) {
-		return nil
-	}
-	var tradeEvent pumpfun.TradeEvent
-	if err := dec.Decode(&tradeEvent); err != nil {
-		return err
-	}
-	memeBuyEvent := types.MemeBuyEvent{
-		Mint:                 tradeEvent.Mint,
-		SolAmount:            tradeEvent.SolAmount,
-		TokenAmount:          tradeEvent.TokenAmount,
-		IsBuy:                tradeEvent.IsBuy,
-		User:                 tradeEvent.User,
-		Timestamp:            tradeEvent.Timestamp,
-		VirtualSolReserves:   tradeEvent.VirtualSolReserves,
-		VirtualTokenReserves: tradeEvent.VirtualTokenReserves,
-	}
-	in.Receipt = []interface{}{&memeBuyEvent}
-	return nil
+	// the sol is moved by system transfers, which have the zero mint
+	memeBuy.SolTransfer = matcher.To(inst1.GetBondingCurveAccount().PublicKey, solana.PublicKey{})
+	memeBuy.FeeTransfer = matcher.To(inst1.GetFeeRecipientAccount().PublicKey, solana.PublicKey{})
+	memeBuy.MintTransfer = matcher.Match(types.TransferMatch{
+		Account:   inst1.GetAssociatedBondingCurveAccount().PublicKey,
+		Mint:      memeBuy.Mint,
+		Direction: types.TransferOut,
+	})
+	in.Event = []types.Event{memeBuy}
+	return matcher.Err()
 }
 
 // Sell
 func ParseSell(inst *pumpfun.Instruction, in *types.Instruction, meta *types.Meta) error {
 	//log.Logger.Info("ignore parse sell", "program", pumpfun.ProgramName)
 	inst1 := inst.Impl.(*pumpfun.Sell)
-	in.ParsedInstruction = inst1
+	matcher := program.NewTransferMatcher(in)
 	memeSell := &types.MemeSell{
 		Dex:                    in.RawInstruction.ProgID,
 		Mint:                   inst1.GetMintAccount().PublicKey,
@@ -162,36 +114,13 @@ func ParseSell(inst *pumpfun.Instruction, in *types.Instruction, meta *types.Met
 		BondingCurve:           inst1.GetBondingCurveAccount().PublicKey,
 		AssociatedBondingCurve: inst1.GetAssociatedBondingCurveAccount().PublicKey,
 	}
-	memeSell.MintTransfer = in.FindChildTransferByTo(inst1.GetAssociatedBondingCurveAccount().PublicKey)
-	in.Event = []interface{}{memeSell}
-
-	myLog := in.FindChildByProgram(pumpfun.ProgramID)
-	if myLog == nil {
-		return nil
-	}
-	data := myLog.RawInstruction.DataBytes
-	dec := ag_binary.NewBorshDecoder(data)
-	instId, _ := dec.ReadBytes(8)
-	eventId, _ := dec.ReadBytes(8)
-	if !bytes.Equal(instId, Instruction_AnchorSelfCPILog[:]) || !bytes.Equal(eventId, Event_Swap[:]) {
-		return nil
-	}
-	var tradeEvent pumpfun.TradeEvent
-	if err := dec.Decode(&tradeEvent); err != nil {
-		return err
-	}
-	memeSellEvent := types.MemeSellEvent{
-		Mint:                 tradeEvent.Mint,
-		SolAmount:            tradeEvent.SolAmount,
-		TokenAmount:          tradeEvent.TokenAmount,
-		IsBuy:                tradeEvent.IsBuy,
-		User:                 tradeEvent.User,
-		Timestamp:            tradeEvent.Timestamp,
-		VirtualSolReserves:   tradeEvent.VirtualSolReserves,
-		VirtualTokenReserves: tradeEvent.VirtualTokenReserves,
-	}
-	in.Receipt = []interface{}{&memeSellEvent}
-	return nil
+	memeSell.MintTransfer = matcher.Match(types.TransferMatch{
+		Account:   inst1.GetAssociatedBondingCurveAccount().PublicKey,
+		Mint:      memeSell.Mint,
+		Direction: types.TransferIn,
+	})
+	in.Event = []types.Event{memeSell}
+	return matcher.Err()
 }
 
 // Sell
@@ -209,3 +138,52 @@ func ParseDefault(inst *pumpfun.Instruction, in *types.Instruction, meta *types.
 func ParseFault(inst *pumpfun.Instruction, in *types.Instruction, meta *types.Meta) {
 	panic("not supported")
 }
+
+// CreateEvent
+func DecodeCreateEvent(programId solana.PublicKey, data []byte) (types.Event, error) {
+	var createEvent pumpfun.CreateEvent
+	if err := ag_binary.NewBorshDecoder(data).Decode(&createEvent); err != nil {
+		return nil, err
+	}
+	return &types.MemeCreateEvent{
+		ProgramId:    programId,
+		Name:         createEvent.Name,
+		Symbol:       createEvent.Symbol,
+		Uri:          createEvent.Uri,
+		Mint:         createEvent.Mint,
+		BondingCurve: createEvent.BondingCurve,
+		User:         createEvent.User,
+	}, nil
+}
+
+// TradeEvent, a buy or a sell
+func DecodeTradeEvent(programId solana.PublicKey, data []byte) (types.Event, error) {
+	var tradeEvent pumpfun.TradeEvent
+	if err := ag_binary.NewBorshDecoder(data).Decode(&tradeEvent); err != nil {
+		return nil, err
+	}
+	if tradeEvent.IsBuy {
+		return &types.MemeBuyEvent{
+			ProgramId:            programId,
+			Mint:                 tradeEvent.Mint,
+			SolAmount:            tradeEvent.SolAmount,
+			TokenAmount:          tradeEvent.TokenAmount,
+			IsBuy:                tradeEvent.IsBuy,
+			User:                 tradeEvent.User,
+			Timestamp:            tradeEvent.Timestamp,
+			VirtualSolReserves:   tradeEvent.VirtualSolReserves,
+			VirtualTokenReserves: tradeEvent.VirtualTokenReserves,
+		}, nil
+	}
+	return &types.MemeSellEvent{
+		ProgramId:            programId,
+		Mint:                 tradeEvent.Mint,
+		SolAmount:            tradeEvent.SolAmount,
+		TokenAmount:          tradeEvent.TokenAmount,
+		IsBuy:                tradeEvent.IsBuy,
+		User:                 tradeEvent.User,
+		Timestamp:            tradeEvent.Timestamp,
+		VirtualSolReserves:   tradeEvent.VirtualSolReserves,
+		VirtualTokenReserves: tradeEvent.VirtualTokenReserves,
+	}, nil
+}
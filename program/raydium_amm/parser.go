@@ -1,11 +1,10 @@
 package raydium_amm
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/raydium_amm"
 )
@@ -46,14 +45,20 @@ func init() {
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
+	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
+	typeID, _ := dec.ReadUint8()
+	if _, ok := Parsers[uint64(typeID)]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := raydium_amm.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -76,6 +81,7 @@ func ParseInitialize(inst *raydium_amm.Instruction, in *types.Instruction, meta
 }
 func ParseInitialize2(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_amm.Initialize2)
+	matcher := program.NewTransferMatcher(in)
 	// the latest three transfer
 	createPool := &types.CreatePool{
 		Dex:     in.RawInstruction.ProgID,
@@ -93,9 +99,10 @@ func ParseInitialize2(inst *raydium_amm.Instruction, in *types.Instruction, meta
 		Pool: inst1.GetAmmAccount().PublicKey,
 		User: inst1.GetUserWalletAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetPoolCoinTokenAccountAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetPoolPcTokenAccountAccount().PublicKey)
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetPoolCoinTokenAccountAccount().PublicKey, inst1.GetCoinMintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetPoolPcTokenAccountAccount().PublicKey, inst1.GetPcMintAccount().PublicKey)
 	pool := &types.Pool{
+		Dex:      in.RawInstruction.ProgID,
 		Hash:     inst1.GetAmmAccount().PublicKey,
 		MintA:    inst1.GetCoinMintAccount().PublicKey,
 		MintB:    inst1.GetPcMintAccount().PublicKey,
@@ -105,36 +112,38 @@ func ParseInitialize2(inst *raydium_amm.Instruction, in *types.Instruction, meta
 		ReserveA: 0,
 		ReserveB: 0,
 	}
-	in.Event = []interface{}{createPool, addLiquidity}
-	in.Receipt = []interface{}{pool}
-	return nil
+	in.Event = []types.Event{createPool, addLiquidity}
+	in.Receipt = []types.Event{pool}
+	return matcher.Err()
 }
 func ParseMonitorStep(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
 }
 func ParseDeposit(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_amm.Deposit)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetAmmAccount().PublicKey,
 		User: inst1.GetUserOwnerAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetPoolCoinTokenAccountAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetPoolPcTokenAccountAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity, addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.ToAccount(meta, inst1.GetPoolCoinTokenAccountAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.ToAccount(meta, inst1.GetPoolPcTokenAccountAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity, addLiquidity}
+	return matcher.Err()
 }
 func ParseWithdraw(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_amm.Withdraw)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetAmmAccount().PublicKey,
 		User: inst1.GetUserOwnerAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetPoolCoinTokenAccountAccount().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetPoolPcTokenAccountAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.FromAccount(meta, inst1.GetPoolCoinTokenAccountAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.FromAccount(meta, inst1.GetPoolPcTokenAccountAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 func ParseMigrateToOpenBook(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
@@ -150,19 +159,19 @@ func ParseWithdrawSrm(inst *raydium_amm.Instruction, in *types.Instruction, meta
 }
 func ParseSwapBaseIn(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_amm.SwapBaseIn)
+	matcher := program.NewTransferMatcher(in)
 	if len(inst1.GetAccounts()) == 17 {
 		inst1.SetAccounts(insertAccount(inst1.GetAccounts(), 4))
 	}
-	in.ParsedInstruction = inst1
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetAmmAccount().PublicKey,
 		User: inst1.GetUserSourceOwnerAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByFrom(inst1.GetUserSourceTokenAccountAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByTo(inst1.GetUserDestinationTokenAccountAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.FromAccount(meta, inst1.GetUserSourceTokenAccountAccount().PublicKey)
+	swap.OutputTransfer = matcher.ToAccount(meta, inst1.GetUserDestinationTokenAccountAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParsePreInitialize(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	log.Logger.Info("ignore parse pre-initialize", "program", raydium_amm.ProgramName)
@@ -170,19 +179,19 @@ func ParsePreInitialize(inst *raydium_amm.Instruction, in *types.Instruction, me
 }
 func ParseSwapBaseOut(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_amm.SwapBaseOut)
+	matcher := program.NewTransferMatcher(in)
 	if len(inst1.GetAccounts()) == 17 {
 		inst1.SetAccounts(insertAccount(inst1.GetAccounts(), 4))
 	}
-	in.ParsedInstruction = inst1
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetAmmAccount().PublicKey,
 		User: inst1.GetUserSourceOwnerAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByFrom(inst1.GetUserSourceTokenAccountAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByTo(inst1.GetUserDestinationTokenAccountAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.FromAccount(meta, inst1.GetUserSourceTokenAccountAccount().PublicKey)
+	swap.OutputTransfer = matcher.ToAccount(meta, inst1.GetUserDestinationTokenAccountAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSimulateInfo(inst *raydium_amm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
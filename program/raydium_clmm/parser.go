@@ -1,13 +1,14 @@
 package raydium_clmm
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/anchor"
 	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/raydium_clmm"
+	"github.com/shopspring/decimal"
 )
 
 var (
@@ -20,6 +21,26 @@ func RegisterParser(id uint64, p Parser) {
 	Parsers[id] = p
 }
 
+var (
+	Event_Swap = anchor.EventDiscriminator("SwapEvent")
+)
+
+// SwapEvent is the SwapEvent of the clmm idl, emitted as a "Program data:" log, the bindings do not generate events
+type SwapEvent struct {
+	PoolState     solana.PublicKey
+	Sender        solana.PublicKey
+	TokenAccount0 solana.PublicKey
+	TokenAccount1 solana.PublicKey
+	Amount0       uint64
+	TransferFee0  uint64
+	Amount1       uint64
+	TransferFee1  uint64
+	ZeroForOne    bool
+	SqrtPriceX64  ag_binary.Uint128
+	Liquidity     ag_binary.Uint128
+	Tick          int32
+}
+
 func init() {
 	program.RegisterParser(raydium_clmm.ProgramID, raydium_clmm.ProgramName, program.Swap, 1, ProgramParser)
 	RegisterParser(uint64(raydium_clmm.Instruction_CreateAmmConfig.Uint32()), ParseCreateAmmConfig)
@@ -46,17 +67,24 @@ func init() {
 	RegisterParser(uint64(raydium_clmm.Instruction_Swap.Uint32()), ParseSwap)
 	RegisterParser(uint64(raydium_clmm.Instruction_SwapV2.Uint32()), ParseSwapV2)
 	RegisterParser(uint64(raydium_clmm.Instruction_SwapRouterBaseIn.Uint32()), ParseSwapRouterBaseIn)
+	program.RegisterEvent(raydium_clmm.ProgramID, Event_Swap, DecodeSwapEvent)
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
+	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
+	typeID, _ := dec.ReadTypeID()
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := raydium_clmm.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -80,7 +108,7 @@ func ParseCreatePool(inst *raydium_clmm.Instruction, in *types.Instruction, meta
 		VaultB:  inst1.GetTokenVault1Account().PublicKey,
 		VaultLP: solana.PublicKey{},
 	}
-	in.Event = []interface{}{createPool}
+	in.Event = []types.Event{createPool}
 	return nil
 }
 func ParseUpdatePoolStatus(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
@@ -123,15 +151,16 @@ func ParseOpenPositionV2(inst *raydium_clmm.Instruction, in *types.Instruction,
 }
 func ParseOpenPositionWithToken22Nft(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_clmm.OpenPositionWithToken22Nft)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetPayerAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetTokenVault0Account().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetTokenVault1Account().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetTokenVault0Account().PublicKey, inst1.GetVault0MintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetTokenVault1Account().PublicKey, inst1.GetVault1MintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseClosePosition(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	// close all accounts
@@ -139,77 +168,83 @@ func ParseClosePosition(inst *raydium_clmm.Instruction, in *types.Instruction, m
 }
 func ParseIncreaseLiquidity(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_clmm.IncreaseLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetNftOwnerAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetTokenVault0Account().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetTokenVault1Account().PublicKey)
-	in.Event = []interface{}{addLiquidity}
+	addLiquidity.TokenATransfer = matcher.ToAccount(meta, inst1.GetTokenVault0Account().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.ToAccount(meta, inst1.GetTokenVault1Account().PublicKey)
+	in.Event = []types.Event{addLiquidity}
 	log.Logger.Info("ignore parse increase liquidity", "program", raydium_clmm.ProgramName)
-	return nil
+	return matcher.Err()
 }
 func ParseIncreaseLiquidityV2(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_clmm.IncreaseLiquidityV2)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetNftOwnerAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetTokenVault0Account().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetTokenVault1Account().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetTokenVault0Account().PublicKey, inst1.GetVault0MintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetTokenVault1Account().PublicKey, inst1.GetVault1MintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseDecreaseLiquidity(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_clmm.DecreaseLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetNftOwnerAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetTokenVault0Account().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetTokenVault1Account().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
+	removeLiquidity.TokenATransfer = matcher.FromAccount(meta, inst1.GetTokenVault0Account().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.FromAccount(meta, inst1.GetTokenVault1Account().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
 	log.Logger.Info("ignore parse decrease liquidity", "program", raydium_clmm.ProgramName)
-	return nil
+	return matcher.Err()
 }
 func ParseDecreaseLiquidityV2(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_clmm.DecreaseLiquidityV2)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetNftOwnerAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetTokenVault0Account().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetTokenVault1Account().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.From(inst1.GetTokenVault0Account().PublicKey, inst1.GetVault0MintAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.From(inst1.GetTokenVault1Account().PublicKey, inst1.GetVault1MintAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 func ParseSwap(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_clmm.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetPayerAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetInputVaultAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetOutputVaultAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.ToAccount(meta, inst1.GetInputVaultAccount().PublicKey)
+	swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetOutputVaultAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSwapV2(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_clmm.SwapV2)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetPayerAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetInputVaultAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetOutputVaultAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.To(inst1.GetInputVaultAccount().PublicKey, inst1.GetInputVaultMintAccount().PublicKey)
+	swap.OutputTransfer = matcher.From(inst1.GetOutputVaultAccount().PublicKey, inst1.GetOutputVaultMintAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSwapRouterBaseIn(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	log.Logger.Info("ignore parse swap router base in", "program", raydium_clmm.ProgramName)
@@ -225,3 +260,26 @@ func ParseDefault(inst *raydium_clmm.Instruction, in *types.Instruction, meta *t
 func ParseFault(inst *raydium_clmm.Instruction, in *types.Instruction, meta *types.Meta) error {
 	panic("not supported")
 }
+
+// SwapEvent
+func DecodeSwapEvent(programId solana.PublicKey, data []byte) (types.Event, error) {
+	var swapEvent SwapEvent
+	if err := ag_binary.NewBorshDecoder(data).Decode(&swapEvent); err != nil {
+		return nil, err
+	}
+	return &types.ClmmSwapEvent{
+		ProgramId:     programId,
+		PoolState:     swapEvent.PoolState,
+		Sender:        swapEvent.Sender,
+		TokenAccount0: swapEvent.TokenAccount0,
+		TokenAccount1: swapEvent.TokenAccount1,
+		Amount0:       swapEvent.Amount0,
+		TransferFee0:  swapEvent.TransferFee0,
+		Amount1:       swapEvent.Amount1,
+		TransferFee1:  swapEvent.TransferFee1,
+		ZeroForOne:    swapEvent.ZeroForOne,
+		SqrtPriceX64:  decimal.NewFromBigInt(swapEvent.SqrtPriceX64.BigInt(), 0),
+		Liquidity:     decimal.NewFromBigInt(swapEvent.Liquidity.BigInt(), 0),
+		Tick:          swapEvent.Tick,
+	}, nil
+}
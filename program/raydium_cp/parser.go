@@ -1,10 +1,10 @@
 package raydium_cp
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/program/anchor"
 	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go"
 	"github.com/gagliardetto/solana-go/programs/raydium_cp"
 )
@@ -19,6 +19,22 @@ func RegisterParser(id uint64, p Parser) {
 	Parsers[id] = p
 }
 
+var (
+	Event_Swap = anchor.EventDiscriminator("SwapEvent")
+)
+
+// SwapEvent is the SwapEvent of the cp swap idl, emitted as a "Program data:" log, the bindings do not generate events
+type SwapEvent struct {
+	PoolId            solana.PublicKey
+	InputVaultBefore  uint64
+	OutputVaultBefore uint64
+	InputAmount       uint64
+	OutputAmount      uint64
+	InputTransferFee  uint64
+	OutputTransferFee uint64
+	BaseInput         bool
+}
+
 func init() {
 	program.RegisterParser(raydium_cp.ProgramID, raydium_cp.ProgramName, program.Swap, 1, ProgramParser)
 	RegisterParser(uint64(raydium_cp.Instruction_CreateAmmConfig.Uint32()), ParseCreateAmmConfig)
@@ -31,17 +47,24 @@ func init() {
 	RegisterParser(uint64(raydium_cp.Instruction_Withdraw.Uint32()), ParseWithdraw)
 	RegisterParser(uint64(raydium_cp.Instruction_SwapBaseInput.Uint32()), ParseSwapBaseInput)
 	RegisterParser(uint64(raydium_cp.Instruction_SwapBaseOutput.Uint32()), ParseSwapBaseOutput)
+	program.RegisterEvent(raydium_cp.ProgramID, Event_Swap, DecodeSwapEvent)
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
+	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
+	typeID, _ := dec.ReadTypeID()
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := raydium_cp.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -64,6 +87,7 @@ func ParseCollectFundFee(inst *raydium_cp.Instruction, in *types.Instruction, me
 func ParseInitialize(inst *raydium_cp.Instruction, in *types.Instruction, meta *types.Meta) error {
 	// log.Logger.Info("ignore parse initialize", "program", raydium_cp.ProgramName)
 	inst1 := inst.Impl.(*raydium_cp.Initialize)
+	matcher := program.NewTransferMatcher(in)
 	createPool := &types.CreatePool{
 		Dex:     in.RawInstruction.ProgID,
 		Pool:    inst1.GetPoolStateAccount().PublicKey,
@@ -80,58 +104,62 @@ func ParseInitialize(inst *raydium_cp.Instruction, in *types.Instruction, meta *
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetCreatorAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetToken0VaultAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetToken1VaultAccount().PublicKey)
-	in.Event = []interface{}{createPool, addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetToken0VaultAccount().PublicKey, inst1.GetToken0MintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetToken1VaultAccount().PublicKey, inst1.GetToken1MintAccount().PublicKey)
+	in.Event = []types.Event{createPool, addLiquidity}
+	return matcher.Err()
 }
 func ParseDeposit(inst *raydium_cp.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_cp.Deposit)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetOwnerAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetToken0VaultAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetToken1VaultAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetToken0VaultAccount().PublicKey, inst1.GetVault0MintAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetToken1VaultAccount().PublicKey, inst1.GetVault1MintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseWithdraw(inst *raydium_cp.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_cp.Withdraw)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetOwnerAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetToken0VaultAccount().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetToken1VaultAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.From(inst1.GetToken0VaultAccount().PublicKey, inst1.GetVault0MintAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.From(inst1.GetToken1VaultAccount().PublicKey, inst1.GetVault1MintAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 func ParseSwapBaseInput(inst *raydium_cp.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_cp.SwapBaseInput)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetPayerAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetInputVaultAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetOutputVaultAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.To(inst1.GetInputVaultAccount().PublicKey, inst1.GetInputTokenMintAccount().PublicKey)
+	swap.OutputTransfer = matcher.From(inst1.GetOutputVaultAccount().PublicKey, inst1.GetOutputTokenMintAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSwapBaseOutput(inst *raydium_cp.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*raydium_cp.SwapBaseOutput)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolStateAccount().PublicKey,
 		User: inst1.GetPayerAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetInputVaultAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetOutputVaultAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.To(inst1.GetInputVaultAccount().PublicKey, inst1.GetInputTokenMintAccount().PublicKey)
+	swap.OutputTransfer = matcher.From(inst1.GetOutputVaultAccount().PublicKey, inst1.GetOutputTokenMintAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 
 // Default
@@ -143,3 +171,22 @@ func ParseDefault(inst *raydium_cp.Instruction, in *types.Instruction, meta *typ
 func ParseFault(inst *raydium_cp.Instruction, in *types.Instruction, meta *types.Meta) error {
 	panic("not supported")
 }
+
+// SwapEvent
+func DecodeSwapEvent(programId solana.PublicKey, data []byte) (types.Event, error) {
+	var swapEvent SwapEvent
+	if err := ag_binary.NewBorshDecoder(data).Decode(&swapEvent); err != nil {
+		return nil, err
+	}
+	return &types.CpSwapEvent{
+		ProgramId:         programId,
+		PoolId:            swapEvent.PoolId,
+		InputVaultBefore:  swapEvent.InputVaultBefore,
+		OutputVaultBefore: swapEvent.OutputVaultBefore,
+		InputAmount:       swapEvent.InputAmount,
+		OutputAmount:      swapEvent.OutputAmount,
+		InputTransferFee:  swapEvent.InputTransferFee,
+		OutputTransferFee: swapEvent.OutputTransferFee,
+		BaseInput:         swapEvent.BaseInput,
+	}, nil
+}
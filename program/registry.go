@@ -0,0 +1,288 @@
+package program
+
+import (
+	"fmt"
+	"sort"
+	"sync"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+)
+
+// Registration describes one program known by a Registry,
+// Id is the canonical id of the program, Aliases are other ids parsed by the same parser
+type Registration struct {
+	Id       solana.PublicKey
+	Aliases  []solana.PublicKey
+	Name     string
+	Type     string
+	Priority int
+	Parser   Parser
+}
+
+// EventDecoder decodes the borsh data of one anchor event, the data starts after the event discriminator,
+// programId is the id of the program which emitted the event, e.g. the id of the program on another cluster
+type EventDecoder func(programId solana.PublicKey, data []byte) (types.Event, error)
+
+// Registry maps program ids to their parsers & to the decoders of their anchor events, it is safe for concurrent use
+type Registry struct {
+	lock     sync.RWMutex
+	programs map[solana.PublicKey]*Registration
+	names    map[string]solana.PublicKey
+	events   map[solana.PublicKey]map[[8]byte]EventDecoder
+}
+
+// DefaultRegistry is filled by the init of every program package
+var DefaultRegistry = NewRegistry()
+
+func NewRegistry() *Registry {
+	return &Registry{
+		programs: make(map[solana.PublicKey]*Registration),
+		names:    make(map[string]solana.PublicKey),
+		events:   make(map[solana.PublicKey]map[[8]byte]EventDecoder),
+	}
+}
+
+// Register adds the parser of a program, a previous registration of the program is replaced with its aliases
+func (r *Registry) Register(program solana.PublicKey, name string, t string, priority int, p Parser) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	registration := &Registration{
+		Id:       program,
+		Name:     name,
+		Type:     t,
+		Priority: priority,
+		Parser:   p,
+	}
+	if previous, ok := r.programs[program]; ok {
+		if previous.Id != program {
+			previous.Aliases = removeKey(previous.Aliases, program)
+		} else {
+			if r.names[previous.Name] == program {
+				delete(r.names, previous.Name)
+			}
+			for _, alias := range previous.Aliases {
+				delete(r.programs, alias)
+			}
+		}
+	}
+	r.programs[program] = registration
+	r.names[name] = program
+}
+
+// Alias makes the parser of a registered program parse the instructions of another id,
+// e.g. the program deployed on another cluster or a redeployed copy of the program
+func (r *Registry) Alias(alias solana.PublicKey, program solana.PublicKey) error {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	registration, ok := r.programs[program]
+	if !ok {
+		return fmt.Errorf("%w: %s", ErrParserNotFound, program)
+	}
+	if alias == registration.Id {
+		return nil
+	}
+	if previous, ok := r.programs[alias]; ok {
+		if previous.Id == alias {
+			return fmt.Errorf("%s is registered as %s", alias, previous.Name)
+		}
+		previous.Aliases = removeKey(previous.Aliases, alias)
+	}
+	registration.Aliases = append(registration.Aliases, alias)
+	r.programs[alias] = registration
+	return nil
+}
+
+// Unregister removes the parser of a program with its aliases & its events, or only the alias if the id is an alias
+func (r *Registry) Unregister(program solana.PublicKey) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	previous, ok := r.programs[program]
+	if !ok {
+		return
+	}
+	delete(r.programs, program)
+	if previous.Id != program {
+		previous.Aliases = removeKey(previous.Aliases, program)
+		return
+	}
+	if r.names[previous.Name] == program {
+		delete(r.names, previous.Name)
+	}
+	for _, alias := range previous.Aliases {
+		delete(r.programs, alias)
+	}
+	delete(r.events, program)
+}
+
+// RegisterEvent adds the decoder of one anchor event of a program, the events of the aliases of the program are decoded too,
+// events can be registered for programs without parser
+func (r *Registry) RegisterEvent(program solana.PublicKey, discriminator [8]byte, d EventDecoder) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	events, ok := r.events[program]
+	if !ok {
+		events = make(map[[8]byte]EventDecoder)
+		r.events[program] = events
+	}
+	events[discriminator] = d
+}
+
+// DecodeEvent decodes an anchor event emitted by a program or one of its aliases,
+// the data starts with the event discriminator
+func (r *Registry) DecodeEvent(programId solana.PublicKey, data []byte) (types.Event, error) {
+	if len(data) < 8 {
+		return nil, fmt.Errorf("%w: data is too short %d", ErrEventNotFound, len(data))
+	}
+	var discriminator [8]byte
+	copy(discriminator[:], data[:8])
+	program := r.Canonical(programId)
+	r.lock.RLock()
+	d, ok := r.events[program][discriminator]
+	r.lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %x", ErrEventNotFound, discriminator)
+	}
+	return d(programId, data[8:])
+}
+
+// copyEvents copies the events of a program from another registry
+func (r *Registry) copyEvents(from *Registry, program solana.PublicKey) {
+	from.lock.RLock()
+	defer from.lock.RUnlock()
+	for discriminator, d := range from.events[program] {
+		r.RegisterEvent(program, discriminator, d)
+	}
+}
+
+// Canonical returns the canonical id of a program or of one of its aliases, the id itself if it is not registered
+func (r *Registry) Canonical(program solana.PublicKey) solana.PublicKey {
+	if registration, ok := r.Lookup(program); ok {
+		return registration.Id
+	}
+	return program
+}
+
+// Lookup returns the registration of a program
+func (r *Registry) Lookup(program solana.PublicKey) (*Registration, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	registration, ok := r.programs[program]
+	return registration, ok
+}
+
+// Id returns the program id registered with the name
+func (r *Registry) Id(name string) (solana.PublicKey, bool) {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	program, ok := r.names[name]
+	return program, ok
+}
+
+// Name returns the name of a program, empty if the program is not registered
+func (r *Registry) Name(program solana.PublicKey) string {
+	if registration, ok := r.Lookup(program); ok {
+		return registration.Name
+	}
+	return ""
+}
+
+// Type returns the type of a program, empty if the program is not registered
+func (r *Registry) Type(program solana.PublicKey) string {
+	if registration, ok := r.Lookup(program); ok {
+		return registration.Type
+	}
+	return ""
+}
+
+// Priority returns the priority of a program, 0 if the program is not registered
+func (r *Registry) Priority(program solana.PublicKey) int {
+	if registration, ok := r.Lookup(program); ok {
+		return registration.Priority
+	}
+	return 0
+}
+
+// Programs lists the registered programs sorted by name
+func (r *Registry) Programs() []*Registration {
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+	registrations := make([]*Registration, 0, len(r.programs))
+	for program, registration := range r.programs {
+		if program != registration.Id {
+			continue
+		}
+		registrations = append(registrations, registration)
+	}
+	sort.Slice(registrations, func(i, j int) bool {
+		if registrations[i].Name != registrations[j].Name {
+			return registrations[i].Name < registrations[j].Name
+		}
+		return registrations[i].Id.String() < registrations[j].Id.String()
+	})
+	return registrations
+}
+
+// Subset returns a new registry with only the programs of the given names, unknown names are ignored
+func (r *Registry) Subset(names ...string) *Registry {
+	subset := NewRegistry()
+	for _, name := range names {
+		program, ok := r.Id(name)
+		if !ok {
+			continue
+		}
+		registration, ok := r.Lookup(program)
+		if !ok {
+			continue
+		}
+		subset.Register(registration.Id, registration.Name, registration.Type, registration.Priority, registration.Parser)
+		for _, alias := range registration.Aliases {
+			subset.Alias(alias, registration.Id)
+		}
+		subset.copyEvents(r, registration.Id)
+	}
+	return subset
+}
+
+// ForCluster returns a new registry with the programs at their ids on the cluster,
+// programs the cluster does not list keep their ids and aliases, all the events are kept
+func (r *Registry) ForCluster(c *Cluster) *Registry {
+	registry := NewRegistry()
+	r.lock.RLock()
+	for program, events := range r.events {
+		for discriminator, d := range events {
+			registry.RegisterEvent(program, discriminator, d)
+		}
+	}
+	r.lock.RUnlock()
+	for _, registration := range r.Programs() {
+		registry.Register(registration.Id, registration.Name, registration.Type, registration.Priority, registration.Parser)
+		aliases, ok := c.Programs[registration.Name]
+		if !ok {
+			aliases = registration.Aliases
+		}
+		for _, alias := range aliases {
+			registry.Alias(alias, registration.Id)
+		}
+	}
+	return registry
+}
+
+func removeKey(keys []solana.PublicKey, key solana.PublicKey) []solana.PublicKey {
+	result := make([]solana.PublicKey, 0, len(keys))
+	for _, k := range keys {
+		if k != key {
+			result = append(result, k)
+		}
+	}
+	return result
+}
+
+// Parse runs the parser of the instruction program, instructions of unknown programs are skipped
+func (r *Registry) Parse(in *types.Instruction, meta *types.Meta) error {
+	registration, ok := r.Lookup(in.RawInstruction.ProgID)
+	if !ok {
+		return nil
+	}
+	return registration.Parser(in, meta)
+}
@@ -1,7 +1,6 @@
 package solfi
 
 import (
-	"errors"
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
 	ag_binary "github.com/gagliardetto/binary"
@@ -40,10 +39,11 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -51,20 +51,21 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 // Swap
 func ParseSwap(inst *solfi.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*solfi.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPairAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
 	if *inst1.A2B == 0 {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetPoolTokenAccountAAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetPoolTokenAccountBAccount().PublicKey)
+		swap.InputTransfer = matcher.ToAccount(meta, inst1.GetPoolTokenAccountAAccount().PublicKey)
+		swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetPoolTokenAccountBAccount().PublicKey)
 	} else {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetPoolTokenAccountAAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetPoolTokenAccountBAccount().PublicKey)
+		swap.InputTransfer = matcher.ToAccount(meta, inst1.GetPoolTokenAccountBAccount().PublicKey)
+		swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetPoolTokenAccountAAccount().PublicKey)
 	}
-	in.Event = []interface{}{swap}
-	return nil
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 
 // Default
@@ -1,7 +1,7 @@
 package spl_token
 
 import (
-	"errors"
+	"fmt"
 
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
@@ -26,7 +26,11 @@ func init() {
 	RegisterParser(uint64(token.Instruction_Transfer), ParseTransfer)
 	RegisterParser(uint64(token.Instruction_TransferChecked), ParseTransferChecked)
 	RegisterParser(uint64(token.Instruction_MintTo), ParseMint)
+	RegisterParser(uint64(token.Instruction_MintToChecked), ParseMintChecked)
 	RegisterParser(uint64(token.Instruction_Burn), ParseBurn)
+	RegisterParser(uint64(token.Instruction_BurnChecked), ParseBurnChecked)
+	RegisterParser(uint64(token.Instruction_InitializeMint), ParseInitializeMint)
+	RegisterParser(uint64(token.Instruction_InitializeMint2), ParseInitializeMint2)
 	RegisterParser(uint64(token.Instruction_InitializeAccount), ParseInitializeAccount)
 	RegisterParser(uint64(token.Instruction_InitializeAccount3), ParseInitializeAccount3)
 }
@@ -41,10 +45,11 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -52,65 +57,122 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 func ParseTransfer(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*token.Transfer)
 	transfer := &types.Transfer{
-		From: inst1.GetSourceAccount().PublicKey,
-		To:   inst1.GetDestinationAccount().PublicKey,
+		ProgramId: in.RawInstruction.ProgID,
+		From:      inst1.GetSourceAccount().PublicKey,
+		To:        inst1.GetDestinationAccount().PublicKey,
+	}
+	// the mint is only known from the token accounts
+	account, ok := meta.TokenAccounts[transfer.From]
+	if !ok {
+		account, ok = meta.TokenAccounts[transfer.To]
+	}
+	if ok {
+		transfer.Mint = account.Mint
 	}
-	mint := meta.TokenAccounts[transfer.From]
-	transfer.Mint = mint.Mint
 	if inst1.Amount != nil {
 		transfer.Amount = *inst1.Amount
 	}
-	in.Event = []interface{}{transfer}
+	in.Event = []types.Event{transfer}
+	if !ok {
+		return fmt.Errorf("%w: %s", program.ErrTokenAccountNotFound, transfer.From)
+	}
 	return nil
 }
 
 func ParseTransferChecked(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*token.TransferChecked)
 	transfer := &types.Transfer{
-		From: inst1.GetSourceAccount().PublicKey,
-		To:   inst1.GetDestinationAccount().PublicKey,
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      inst1.GetMintAccount().PublicKey,
+		From:      inst1.GetSourceAccount().PublicKey,
+		To:        inst1.GetDestinationAccount().PublicKey,
 	}
-	mint := meta.TokenAccounts[transfer.From]
-	transfer.Mint = mint.Mint
 	if inst1.Amount != nil {
 		transfer.Amount = *inst1.Amount
 	}
-	in.Event = []interface{}{transfer}
+	addDecimals(meta, transfer.Mint, inst1.Decimals)
+	in.Event = []types.Event{transfer}
 	return nil
 }
 
 func ParseMint(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*token.MintTo)
 	mintTo := &types.MintTo{
-		Mint:    inst1.GetMintAccount().PublicKey,
-		Account: inst1.GetDestinationAccount().PublicKey,
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      inst1.GetMintAccount().PublicKey,
+		Account:   inst1.GetDestinationAccount().PublicKey,
+	}
+	if inst1.Amount != nil {
+		mintTo.Amount = *inst1.Amount
+	}
+	in.Event = []types.Event{mintTo}
+	return nil
+}
+
+func ParseMintChecked(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
+	inst1 := inst.Impl.(*token.MintToChecked)
+	mintTo := &types.MintTo{
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      inst1.GetMintAccount().PublicKey,
+		Account:   inst1.GetDestinationAccount().PublicKey,
 	}
 	if inst1.Amount != nil {
 		mintTo.Amount = *inst1.Amount
 	}
-	in.Event = []interface{}{mintTo}
+	addDecimals(meta, mintTo.Mint, inst1.Decimals)
+	in.Event = []types.Event{mintTo}
 	return nil
 }
 
 func ParseBurn(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*token.Burn)
 	burn := &types.Burn{
-		Mint:    inst1.GetMintAccount().PublicKey,
-		Account: inst1.GetSourceAccount().PublicKey,
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      inst1.GetMintAccount().PublicKey,
+		Account:   inst1.GetSourceAccount().PublicKey,
+	}
+	if inst1.Amount != nil {
+		burn.Amount = *inst1.Amount
+	}
+	in.Event = []types.Event{burn}
+	return nil
+}
+
+func ParseBurnChecked(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
+	inst1 := inst.Impl.(*token.BurnChecked)
+	burn := &types.Burn{
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      inst1.GetMintAccount().PublicKey,
+		Account:   inst1.GetSourceAccount().PublicKey,
 	}
 	if inst1.Amount != nil {
 		burn.Amount = *inst1.Amount
 	}
-	in.Event = []interface{}{burn}
+	addDecimals(meta, burn.Mint, inst1.Decimals)
+	in.Event = []types.Event{burn}
+	return nil
+}
+
+// InitializeMint only adds the decimals of the mint, the ui amounts of the transaction use them
+func ParseInitializeMint(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
+	inst1 := inst.Impl.(*token.InitializeMint)
+	addDecimals(meta, inst1.GetMintAccount().PublicKey, inst1.Decimals)
+	return nil
+}
+
+func ParseInitializeMint2(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
+	inst1 := inst.Impl.(*token.InitializeMint2)
+	addDecimals(meta, inst1.GetMintAccount().PublicKey, inst1.Decimals)
 	return nil
 }
 
 func ParseInitializeAccount(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*token.InitializeAccount)
 	init := &types.Initialize{
-		Mint:    inst1.GetMintAccount().PublicKey,
-		Account: inst1.GetAccount().PublicKey,
-		Owner:   inst1.GetOwnerAccount().PublicKey,
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      inst1.GetMintAccount().PublicKey,
+		Account:   inst1.GetAccount().PublicKey,
+		Owner:     inst1.GetOwnerAccount().PublicKey,
 	}
 	// update token owner & mint by spl token instructions
 	meta.TokenAccounts[init.Account] = &types.TokenAccount{
@@ -118,15 +180,16 @@ func ParseInitializeAccount(inst *token.Instruction, in *types.Instruction, meta
 		ProgramId: &in.RawInstruction.ProgID,
 		Mint:      init.Mint,
 	}
-	in.Event = []interface{}{init}
+	in.Event = []types.Event{init}
 	return nil
 }
 
 func ParseInitializeAccount3(inst *token.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*token.InitializeAccount3)
 	init := &types.Initialize{
-		Mint:    inst1.GetMintAccount().PublicKey,
-		Account: inst1.GetAccount().PublicKey,
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      inst1.GetMintAccount().PublicKey,
+		Account:   inst1.GetAccount().PublicKey,
 	}
 	if inst1.Owner != nil {
 		init.Owner = *inst1.Owner
@@ -137,6 +200,20 @@ func ParseInitializeAccount3(inst *token.Instruction, in *types.Instruction, met
 		ProgramId: &in.RawInstruction.ProgID,
 		Mint:      init.Mint,
 	}
-	in.Event = []interface{}{init}
+	in.Event = []types.Event{init}
 	return nil
 }
+
+// addDecimals adds the decimals of a mint known from an instruction, the decimals of the token balances are kept
+func addDecimals(meta *types.Meta, mint solana.PublicKey, decimals *uint8) {
+	if decimals == nil {
+		return
+	}
+	if _, ok := meta.MintAccounts[mint]; ok {
+		return
+	}
+	meta.MintAccounts[mint] = &types.MintAccount{
+		Mint:     mint,
+		Decimals: *decimals,
+	}
+}
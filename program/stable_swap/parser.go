@@ -1,11 +1,10 @@
 package stable_swap
 
 import (
-	"errors"
-
 	"github.com/blockchain-develop/solana-parser/log"
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
+	ag_binary "github.com/gagliardetto/binary"
 	"github.com/gagliardetto/solana-go/programs/stable_swap"
 )
 
@@ -40,14 +39,20 @@ func init() {
 }
 
 func ProgramParser(in *types.Instruction, meta *types.Meta) error {
+	dec := ag_binary.NewBorshDecoder(in.RawInstruction.DataBytes)
+	typeID, _ := dec.ReadTypeID()
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := stable_swap.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -69,14 +74,15 @@ func ParseCreateStrategy(inst *stable_swap.Instruction, in *types.Instruction, m
 }
 func ParseDeposit(inst *stable_swap.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*stable_swap.Deposit)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetVaultTokenAAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetVaultTokenAAccount().PublicKey, inst1.GetMintAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseExecStrategy(inst *stable_swap.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
@@ -96,27 +102,29 @@ func ParseShutdown(inst *stable_swap.Instruction, in *types.Instruction, meta *t
 }
 func ParseSwap(inst *stable_swap.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*stable_swap.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetUserTokenInAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetUserTokenOutAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.FromAccount(meta, inst1.GetUserTokenInAccount().PublicKey)
+	swap.OutputTransfer = matcher.ToAccount(meta, inst1.GetUserTokenOutAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseSwapV2(inst *stable_swap.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*stable_swap.SwapV2)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetUserTokenInAccount().PublicKey)
-	swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetUserTokenOutAccount().PublicKey)
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.From(inst1.GetUserTokenInAccount().PublicKey, inst1.GetMintInAccount().PublicKey)
+	swap.OutputTransfer = matcher.To(inst1.GetUserTokenOutAccount().PublicKey, inst1.GetMintOutAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseTransferOwner(inst *stable_swap.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
@@ -126,15 +134,16 @@ func ParseUnpause(inst *stable_swap.Instruction, in *types.Instruction, meta *ty
 }
 func ParseWithdraw(inst *stable_swap.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*stable_swap.Withdraw)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetPoolAccount().PublicKey,
 		User: inst1.GetUserAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetVaultTokenAAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
+	removeLiquidity.TokenATransfer = matcher.From(inst1.GetVaultTokenAAccount().PublicKey, inst1.GetMintAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
 	// log.Logger.Info("ignore parse withdraw", "program", stable_swap.ProgramName)
-	return nil
+	return matcher.Err()
 }
 
 // Default
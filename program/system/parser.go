@@ -2,7 +2,6 @@ package system
 
 import (
 	"encoding/binary"
-	"errors"
 
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
@@ -37,10 +36,11 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -48,13 +48,14 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 func ParseTransfer(inst *system.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*system.Transfer)
 	transfer := &types.Transfer{
-		Mint: solana.MustPublicKeyFromBase58("11111111111111111111111111111111"),
-		From: inst1.GetFundingAccount().PublicKey,
-		To:   inst1.GetRecipientAccount().PublicKey,
+		ProgramId: in.RawInstruction.ProgID,
+		Mint:      solana.MustPublicKeyFromBase58("11111111111111111111111111111111"),
+		From:      inst1.GetFundingAccount().PublicKey,
+		To:        inst1.GetRecipientAccount().PublicKey,
 	}
 	if inst1.Lamports != nil {
 		transfer.Amount = *inst1.Lamports
 	}
-	in.Event = []interface{}{transfer}
+	in.Event = []types.Event{transfer}
 	return nil
 }
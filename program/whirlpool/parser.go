@@ -1,7 +1,6 @@
 package whirlpool
 
 import (
-	"errors"
 	"github.com/blockchain-develop/solana-parser/program"
 	"github.com/blockchain-develop/solana-parser/types"
 	ag_binary "github.com/gagliardetto/binary"
@@ -80,14 +79,18 @@ func ProgramParser(in *types.Instruction, meta *types.Meta) error {
 	if typeID == Instruction_ClosePositionWithTokenExtensions || typeID == Instruction_OpenPositionWithTokenExtensions {
 		return nil
 	}
+	if _, ok := Parsers[uint64(typeID.Uint32())]; !ok {
+		return program.ErrParserNotFound
+	}
 	inst, err := whirlpool.DecodeInstruction(in.RawInstruction.AccountValues, in.RawInstruction.DataBytes)
 	if err != nil {
 		return err
 	}
+	in.ParsedInstruction = inst.Impl
 	id := uint64(inst.TypeID.Uint32())
 	parser, ok := Parsers[id]
 	if !ok {
-		return errors.New("parser not found")
+		return program.ErrParserNotFound
 	}
 	return parser(inst, in, meta)
 }
@@ -109,7 +112,7 @@ func ParseInitializePool(inst *whirlpool.Instruction, in *types.Instruction, met
 		VaultB:  inst1.GetTokenVaultBAccount().PublicKey,
 		VaultLP: solana.PublicKey{},
 	}
-	in.Event = []interface{}{createPool}
+	in.Event = []types.Event{createPool}
 	return nil
 }
 func ParseInitializeTickArray(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
@@ -135,27 +138,29 @@ func ParseOpenPositionWithMetadata(inst *whirlpool.Instruction, in *types.Instru
 }
 func ParseIncreaseLiquidity(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.IncreaseLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolAccount().PublicKey,
 		User: inst1.GetPositionAuthorityAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetTokenVaultAAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultBAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.ToAccount(meta, inst1.GetTokenVaultAAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.ToAccount(meta, inst1.GetTokenVaultBAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseDecreaseLiquidity(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.DecreaseLiquidity)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolAccount().PublicKey,
 		User: inst1.GetPositionAuthorityAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultAAccount().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultBAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.FromAccount(meta, inst1.GetTokenVaultAAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.FromAccount(meta, inst1.GetTokenVaultBAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 func ParseUpdateFeesAndRewards(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
@@ -171,20 +176,21 @@ func ParseCollectProtocolFees(inst *whirlpool.Instruction, in *types.Instruction
 }
 func ParseSwap(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.Swap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolAccount().PublicKey,
 		User: inst1.GetTokenAuthorityAccount().PublicKey,
 	}
 	if *inst1.AToB {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultAAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultBAccount().PublicKey)
+		swap.InputTransfer = matcher.ToAccount(meta, inst1.GetTokenVaultAAccount().PublicKey)
+		swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetTokenVaultBAccount().PublicKey)
 	} else {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultBAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultAAccount().PublicKey)
+		swap.InputTransfer = matcher.ToAccount(meta, inst1.GetTokenVaultBAccount().PublicKey)
+		swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetTokenVaultAAccount().PublicKey)
 	}
-	in.Event = []interface{}{swap}
-	return nil
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseClosePosition(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	// close all accounts
@@ -219,23 +225,24 @@ func ParseSetRewardEmissionsSuperAuthority(inst *whirlpool.Instruction, in *type
 }
 func ParseTwoHopSwap(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.TwoHopSwap)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolOneAccount().PublicKey,
 		User: inst1.GetTokenAuthorityAccount().PublicKey,
 	}
 	if *inst1.AToBOne {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultOneAAccount().PublicKey)
+		swap.InputTransfer = matcher.ToAccount(meta, inst1.GetTokenVaultOneAAccount().PublicKey)
 	} else {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultOneBAccount().PublicKey)
+		swap.InputTransfer = matcher.ToAccount(meta, inst1.GetTokenVaultOneBAccount().PublicKey)
 	}
 	if *inst1.AToBTwo {
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultTwoBAccount().PublicKey)
+		swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetTokenVaultTwoBAccount().PublicKey)
 	} else {
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultTwoAAccount().PublicKey)
+		swap.OutputTransfer = matcher.FromAccount(meta, inst1.GetTokenVaultTwoAAccount().PublicKey)
 	}
-	in.Event = []interface{}{swap}
-	return nil
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseInitializePositionBundle(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
@@ -268,27 +275,29 @@ func ParseCollectRewardV2(inst *whirlpool.Instruction, in *types.Instruction, me
 }
 func ParseDecreaseLiquidityV2(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.DecreaseLiquidityV2)
+	matcher := program.NewTransferMatcher(in)
 	removeLiquidity := &types.RemoveLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolAccount().PublicKey,
 		User: inst1.GetPositionAuthorityAccount().PublicKey,
 	}
-	removeLiquidity.TokenATransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultAAccount().PublicKey)
-	removeLiquidity.TokenBTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultBAccount().PublicKey)
-	in.Event = []interface{}{removeLiquidity}
-	return nil
+	removeLiquidity.TokenATransfer = matcher.From(inst1.GetTokenVaultAAccount().PublicKey, inst1.GetTokenMintAAccount().PublicKey)
+	removeLiquidity.TokenBTransfer = matcher.From(inst1.GetTokenVaultBAccount().PublicKey, inst1.GetTokenMintBAccount().PublicKey)
+	in.Event = []types.Event{removeLiquidity}
+	return matcher.Err()
 }
 func ParseIncreaseLiquidityV2(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.IncreaseLiquidityV2)
+	matcher := program.NewTransferMatcher(in)
 	addLiquidity := &types.AddLiquidity{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolAccount().PublicKey,
 		User: inst1.GetPositionAuthorityAccount().PublicKey,
 	}
-	addLiquidity.TokenATransfer = in.FindChildTransferByTo(inst1.GetTokenVaultAAccount().PublicKey)
-	addLiquidity.TokenBTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultBAccount().PublicKey)
-	in.Event = []interface{}{addLiquidity}
-	return nil
+	addLiquidity.TokenATransfer = matcher.To(inst1.GetTokenVaultAAccount().PublicKey, inst1.GetTokenMintAAccount().PublicKey)
+	addLiquidity.TokenBTransfer = matcher.To(inst1.GetTokenVaultBAccount().PublicKey, inst1.GetTokenMintBAccount().PublicKey)
+	in.Event = []types.Event{addLiquidity}
+	return matcher.Err()
 }
 func ParseInitializePoolV2(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	// log.Logger.Info("ignore parse initialize pool v2", "program", whirlpool.ProgramName)
@@ -304,7 +313,7 @@ func ParseInitializePoolV2(inst *whirlpool.Instruction, in *types.Instruction, m
 		VaultB:  inst1.GetTokenVaultBAccount().PublicKey,
 		VaultLP: solana.PublicKey{},
 	}
-	in.Event = []interface{}{createPool}
+	in.Event = []types.Event{createPool}
 	return nil
 }
 func ParseInitializeRewardV2(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
@@ -317,33 +326,34 @@ func ParseSetRewardEmissionsV2(inst *whirlpool.Instruction, in *types.Instructio
 }
 func ParseSwapV2(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.SwapV2)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolAccount().PublicKey,
 		User: inst1.GetTokenAuthorityAccount().PublicKey,
 	}
 	if *inst1.AToB {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultAAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultBAccount().PublicKey)
+		swap.InputTransfer = matcher.To(inst1.GetTokenVaultAAccount().PublicKey, inst1.GetTokenMintAAccount().PublicKey)
+		swap.OutputTransfer = matcher.From(inst1.GetTokenVaultBAccount().PublicKey, inst1.GetTokenMintBAccount().PublicKey)
 	} else {
-		swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultBAccount().PublicKey)
-		swap.OutputTransfer = in.FindChildTransferByFrom(inst1.GetTokenVaultAAccount().PublicKey)
+		swap.InputTransfer = matcher.To(inst1.GetTokenVaultBAccount().PublicKey, inst1.GetTokenMintBAccount().PublicKey)
+		swap.OutputTransfer = matcher.From(inst1.GetTokenVaultAAccount().PublicKey, inst1.GetTokenMintAAccount().PublicKey)
 	}
-	in.Event = []interface{}{swap}
-	return nil
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseTwoHopSwapV2(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	inst1 := inst.Impl.(*whirlpool.TwoHopSwapV2)
+	matcher := program.NewTransferMatcher(in)
 	swap := &types.Swap{
 		Dex:  in.RawInstruction.ProgID,
 		Pool: inst1.GetWhirlpoolOneAccount().PublicKey,
 		User: inst1.GetTokenAuthorityAccount().PublicKey,
 	}
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultOneInputAccount().PublicKey)
-	swap.InputTransfer = in.FindChildTransferByTo(inst1.GetTokenVaultTwoOutputAccount().PublicKey)
-
-	in.Event = []interface{}{swap}
-	return nil
+	swap.InputTransfer = matcher.To(inst1.GetTokenVaultOneInputAccount().PublicKey, inst1.GetTokenMintInputAccount().PublicKey)
+	swap.OutputTransfer = matcher.From(inst1.GetTokenVaultTwoOutputAccount().PublicKey, inst1.GetTokenMintOutputAccount().PublicKey)
+	in.Event = []types.Event{swap}
+	return matcher.Err()
 }
 func ParseInitializeConfigExtension(inst *whirlpool.Instruction, in *types.Instruction, meta *types.Meta) error {
 	return nil
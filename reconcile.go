@@ -0,0 +1,65 @@
+package solanaparser
+
+import (
+	"bytes"
+	"fmt"
+	"math/big"
+	"sort"
+
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
+
+// reconcile sums the transfer, mint to and burn events per token account
+// and reports every account whose sum differs from the token balance change
+func reconcile(t *types.Transaction) {
+	decoded := make(map[solana.PublicKey]decimal.Decimal)
+	t.Walk(func(in *types.Instruction) bool {
+		for _, event := range in.Event {
+			switch e := event.(type) {
+			case *types.Transfer:
+				amount := decimal.NewFromBigInt(new(big.Int).SetUint64(e.Amount), 0)
+				decoded[e.From] = decoded[e.From].Sub(amount)
+				decoded[e.To] = decoded[e.To].Add(amount)
+			case *types.MintTo:
+				decoded[e.Account] = decoded[e.Account].Add(decimal.NewFromBigInt(new(big.Int).SetUint64(e.Amount), 0))
+			case *types.Burn:
+				decoded[e.Account] = decoded[e.Account].Sub(decimal.NewFromBigInt(new(big.Int).SetUint64(e.Amount), 0))
+			}
+		}
+		return true
+	})
+	accounts := make([]solana.PublicKey, 0)
+	for account := range t.Meta.TokenAccounts {
+		_, pre := t.Meta.TokenPreBalance[account]
+		_, post := t.Meta.TokenPostBalance[account]
+		if pre || post {
+			accounts = append(accounts, account)
+		}
+	}
+	sort.Slice(accounts, func(i, j int) bool {
+		return bytes.Compare(accounts[i][:], accounts[j][:]) < 0
+	})
+	for _, account := range accounts {
+		tokenAccount := t.Meta.TokenAccounts[account]
+		// wrapped sol is also moved by lamport transfers & sync native
+		if tokenAccount.Mint == solana.SolMint {
+			continue
+		}
+		expected := t.Meta.TokenPostBalance[account].Sub(t.Meta.TokenPreBalance[account])
+		if decoded[account].Equal(expected) {
+			continue
+		}
+		diagnostic := &types.Diagnostic{
+			Signature: t.Hash,
+			Account:   account,
+			Kind:      types.DiagnosticBalanceMismatch,
+			Message:   fmt.Sprintf("mint %s: decoded %s, balance change %s", tokenAccount.Mint, decoded[account], expected),
+		}
+		if tokenAccount.ProgramId != nil {
+			diagnostic.Program = *tokenAccount.ProgramId
+		}
+		t.Diagnostics = append(t.Diagnostics, diagnostic)
+	}
+}
@@ -0,0 +1,74 @@
+package solanaparser
+
+import (
+	"github.com/blockchain-develop/solana-parser/program"
+	"github.com/blockchain-develop/solana-parser/types"
+)
+
+// summarize picks the primary actions of a transaction from the instruction tree,
+// the events of an instruction are kept when no instruction below it has events of a higher priority,
+// otherwise the children are visited, e.g. a jupiter route is kept over its raydium swaps which are kept over their transfers
+func summarize(t *types.Transaction, registry *program.Registry) {
+	actions := make([]*types.PrimaryAction, 0)
+	for _, in := range t.Instructions {
+		actions = summarizeInstruction(in, registry, actions)
+	}
+	t.Actions = actions
+}
+
+func summarizeInstruction(in *types.Instruction, registry *program.Registry, actions []*types.PrimaryAction) []*types.PrimaryAction {
+	registration, _ := registry.Lookup(in.RawInstruction.ProgID)
+	priority := 0
+	if registration != nil {
+		priority = registration.Priority
+	}
+	events := primaryEvents(in)
+	if len(events) > 0 && priority >= maxEventPriority(in.Children, registry) {
+		for _, event := range events {
+			action := &types.PrimaryAction{
+				Path:     in.Path,
+				Program:  in.RawInstruction.ProgID,
+				Priority: priority,
+				Action:   event,
+			}
+			if registration != nil {
+				action.Name = registration.Name
+				action.Type = registration.Type
+			}
+			actions = append(actions, action)
+		}
+		return actions
+	}
+	for _, child := range in.Children {
+		actions = summarizeInstruction(child, registry, actions)
+	}
+	return actions
+}
+
+// maxEventPriority is the highest priority of the instructions with events in the trees, -1 if there is none
+func maxEventPriority(instructions []*types.Instruction, registry *program.Registry) int {
+	max := -1
+	for _, in := range instructions {
+		if len(primaryEvents(in)) > 0 {
+			if priority := registry.Priority(in.RawInstruction.ProgID); priority > max {
+				max = priority
+			}
+		}
+		if priority := maxEventPriority(in.Children, registry); priority > max {
+			max = priority
+		}
+	}
+	return max
+}
+
+// primaryEvents are the events of the instruction which may be primary actions, unknown instructions are ignored
+func primaryEvents(in *types.Instruction) []types.Event {
+	events := make([]types.Event, 0, len(in.Event))
+	for _, event := range in.Event {
+		if _, ok := event.(*types.UnknownInstruction); ok {
+			continue
+		}
+		events = append(events, event)
+	}
+	return events
+}
@@ -0,0 +1,160 @@
+package solanaparser
+
+import (
+	"github.com/blockchain-develop/solana-parser/types"
+	"github.com/gagliardetto/solana-go"
+)
+
+// fillSwapAmounts sets the normalized amounts of the swaps after the whole transaction is parsed,
+// the children are filled before their parents so that the transfers of a swap are preferred to the events of a router
+func fillSwapAmounts(t *types.Transaction) {
+	var fill func(in *types.Instruction)
+	fill = func(in *types.Instruction) {
+		for _, child := range in.Children {
+			fill(child)
+		}
+		for _, event := range in.Event {
+			switch e := event.(type) {
+			case *types.Swap:
+				fillSwap(t.Meta, e, in)
+			case *types.MemeBuy:
+				fillMemeBuy(t.Meta, e, in.Receipt)
+			case *types.MemeSell:
+				fillMemeSell(t.Meta, e, in.Receipt)
+			case *types.Route:
+				for _, step := range e.RouteSteps {
+					if step.Swap == nil || step.Swap.Source != "" || step.SwapEvent == nil {
+						continue
+					}
+					fillSwapEvent(t.Meta, &step.Swap.SwapAmounts, step.SwapEvent)
+				}
+			}
+		}
+	}
+	for _, in := range t.Instructions {
+		fill(in)
+	}
+}
+
+func fillSwap(meta *types.Meta, swap *types.Swap, in *types.Instruction) {
+	if hasMint(swap.InputTransfer) && hasMint(swap.OutputTransfer) {
+		setSwapAmounts(meta, &swap.SwapAmounts, types.AmountSourceTransfer,
+			swap.InputTransfer.Mint, swap.InputTransfer.Amount, swap.OutputTransfer.Mint, swap.OutputTransfer.Amount)
+		return
+	}
+	for _, receipt := range in.Receipt {
+		switch event := receipt.(type) {
+		case *types.SwapEvent:
+			fillSwapEvent(meta, &swap.SwapAmounts, event)
+			return
+		case *types.ClmmSwapEvent:
+			if fillClmmSwapEvent(meta, &swap.SwapAmounts, event) {
+				return
+			}
+		case *types.CpSwapEvent:
+			if fillCpSwapEvent(meta, &swap.SwapAmounts, event, in.ParsedInstruction) {
+				return
+			}
+		case *types.DlmmSwapEvent:
+			if fillDlmmSwapEvent(meta, &swap.SwapAmounts, event, in.ParsedInstruction) {
+				return
+			}
+		}
+	}
+}
+
+func fillSwapEvent(meta *types.Meta, amounts *types.SwapAmounts, event *types.SwapEvent) {
+	setSwapAmounts(meta, amounts, types.AmountSourceEvent, event.InputMint, event.InputAmount, event.OutputMint, event.OutputAmount)
+}
+
+// the mints of a clmm swap event are the mints of the token accounts of the payer
+func fillClmmSwapEvent(meta *types.Meta, amounts *types.SwapAmounts, event *types.ClmmSwapEvent) bool {
+	account0, ok0 := meta.TokenAccounts[event.TokenAccount0]
+	account1, ok1 := meta.TokenAccounts[event.TokenAccount1]
+	if !ok0 || !ok1 {
+		return false
+	}
+	if event.ZeroForOne {
+		setSwapAmounts(meta, amounts, types.AmountSourceEvent, account0.Mint, event.Amount0, account1.Mint, event.Amount1)
+	} else {
+		setSwapAmounts(meta, amounts, types.AmountSourceEvent, account1.Mint, event.Amount1, account0.Mint, event.Amount0)
+	}
+	return true
+}
+
+// a cp swap event has no mint, the mints are the accounts of the swap instruction
+func fillCpSwapEvent(meta *types.Meta, amounts *types.SwapAmounts, event *types.CpSwapEvent, instruction interface{}) bool {
+	mints, ok := instruction.(interface {
+		GetInputTokenMintAccount() *solana.AccountMeta
+		GetOutputTokenMintAccount() *solana.AccountMeta
+	})
+	if !ok || mints.GetInputTokenMintAccount() == nil || mints.GetOutputTokenMintAccount() == nil {
+		return false
+	}
+	setSwapAmounts(meta, amounts, types.AmountSourceEvent,
+		mints.GetInputTokenMintAccount().PublicKey, event.InputAmount, mints.GetOutputTokenMintAccount().PublicKey, event.OutputAmount)
+	return true
+}
+
+// a dlmm swap event has no mint, the mints are the accounts of the swap instruction & the input is x when swapping for y
+func fillDlmmSwapEvent(meta *types.Meta, amounts *types.SwapAmounts, event *types.DlmmSwapEvent, instruction interface{}) bool {
+	mints, ok := instruction.(interface {
+		GetTokenXMintAccount() *solana.AccountMeta
+		GetTokenYMintAccount() *solana.AccountMeta
+	})
+	if !ok || mints.GetTokenXMintAccount() == nil || mints.GetTokenYMintAccount() == nil {
+		return false
+	}
+	mintIn, mintOut := mints.GetTokenXMintAccount().PublicKey, mints.GetTokenYMintAccount().PublicKey
+	if !event.SwapForY {
+		mintIn, mintOut = mintOut, mintIn
+	}
+	setSwapAmounts(meta, amounts, types.AmountSourceEvent, mintIn, event.AmountIn, mintOut, event.AmountOut)
+	return true
+}
+
+// a meme buy pays sol, the native sol of system transfers uses the zero mint
+func fillMemeBuy(meta *types.Meta, buy *types.MemeBuy, receipts []types.Event) {
+	if hasMint(buy.SolTransfer) && buy.MintTransfer != nil {
+		setSwapAmounts(meta, &buy.SwapAmounts, types.AmountSourceTransfer,
+			buy.SolTransfer.Mint, buy.SolTransfer.Amount, buy.Mint, buy.MintTransfer.Amount)
+		return
+	}
+	for _, receipt := range receipts {
+		if event, ok := receipt.(*types.MemeBuyEvent); ok && event.Mint == buy.Mint {
+			setSwapAmounts(meta, &buy.SwapAmounts, types.AmountSourceEvent,
+				solana.PublicKey{}, event.SolAmount, event.Mint, event.TokenAmount)
+			return
+		}
+	}
+}
+
+// the sol of a meme sell is moved out of the bonding curve without a transfer, only the event has it
+func fillMemeSell(meta *types.Meta, sell *types.MemeSell, receipts []types.Event) {
+	for _, receipt := range receipts {
+		if event, ok := receipt.(*types.MemeSellEvent); ok && event.Mint == sell.Mint {
+			setSwapAmounts(meta, &sell.SwapAmounts, types.AmountSourceEvent,
+				event.Mint, event.TokenAmount, solana.PublicKey{}, event.SolAmount)
+			return
+		}
+	}
+}
+
+// hasMint reports whether the transfer is known with its mint, the mint of a token transfer is unknown without its token accounts
+func hasMint(transfer *types.Transfer) bool {
+	return transfer != nil && (!transfer.Mint.IsZero() || transfer.ProgramId == solana.SystemProgramID)
+}
+
+// setSwapAmounts sets the amounts & the price, the price is left zero when the decimals of a mint are unknown
+func setSwapAmounts(meta *types.Meta, amounts *types.SwapAmounts, source string, mintIn solana.PublicKey, amountIn uint64, mintOut solana.PublicKey, amountOut uint64) {
+	amounts.MintIn = mintIn
+	amounts.AmountIn = amountIn
+	amounts.MintOut = mintOut
+	amounts.AmountOut = amountOut
+	amounts.Source = source
+	_, in, okIn := uiAmount(meta, mintIn, amountIn)
+	_, out, okOut := uiAmount(meta, mintOut, amountOut)
+	if okIn && okOut && !in.IsZero() {
+		amounts.Price = out.Div(in)
+	}
+}
@@ -1,6 +1,9 @@
 package types
 
-import "github.com/gagliardetto/solana-go"
+import (
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
 
 // amm dex
 type CreatePool struct {
@@ -33,12 +36,29 @@ type RemoveLiquidity struct {
 	TokenLpBurn    *Burn
 }
 
+const (
+	AmountSourceTransfer = "transfer"
+	AmountSourceEvent    = "event"
+)
+
+// SwapAmounts are the normalized amounts of a swap, filled from its transfers or from the swap events of its receipts,
+// Price is the decimal adjusted AmountOut/AmountIn, Source is AmountSourceTransfer, AmountSourceEvent or empty if unknown
+type SwapAmounts struct {
+	MintIn    solana.PublicKey
+	MintOut   solana.PublicKey
+	AmountIn  uint64
+	AmountOut uint64
+	Price     decimal.Decimal
+	Source    string
+}
+
 type Swap struct {
 	Dex            solana.PublicKey
 	Pool           solana.PublicKey
 	User           solana.PublicKey
 	InputTransfer  *Transfer
 	OutputTransfer *Transfer
+	SwapAmounts
 }
 
 type RoutePlan struct {
@@ -57,30 +77,40 @@ type Route struct {
 	RouteSteps []*RouteStep
 }
 
-// spl token
+// spl token, Decimals & UiAmount are set when the decimals of the mint are known in the transaction
 type Transfer struct {
-	Mint   solana.PublicKey
-	From   solana.PublicKey
-	To     solana.PublicKey
-	Amount uint64
+	ProgramId solana.PublicKey
+	Mint      solana.PublicKey
+	From      solana.PublicKey
+	To        solana.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	UiAmount  decimal.Decimal
 }
 
 type MintTo struct {
-	Mint    solana.PublicKey
-	Account solana.PublicKey
-	Amount  uint64
+	ProgramId solana.PublicKey
+	Mint      solana.PublicKey
+	Account   solana.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	UiAmount  decimal.Decimal
 }
 
 type Burn struct {
-	Mint    solana.PublicKey
-	Account solana.PublicKey
-	Amount  uint64
+	ProgramId solana.PublicKey
+	Mint      solana.PublicKey
+	Account   solana.PublicKey
+	Amount    uint64
+	Decimals  uint8
+	UiAmount  decimal.Decimal
 }
 
 type Initialize struct {
-	Account solana.PublicKey
-	Owner   solana.PublicKey
-	Mint    solana.PublicKey
+	ProgramId solana.PublicKey
+	Account   solana.PublicKey
+	Owner     solana.PublicKey
+	Mint      solana.PublicKey
 }
 
 // pump.fun
@@ -102,6 +132,7 @@ type MemeBuy struct {
 	MintTransfer           *Transfer
 	SolTransfer            *Transfer
 	FeeTransfer            *Transfer
+	SwapAmounts
 }
 
 type MemeSell struct {
@@ -111,4 +142,17 @@ type MemeSell struct {
 	BondingCurve           solana.PublicKey
 	AssociatedBondingCurve solana.PublicKey
 	MintTransfer           *Transfer
+	SwapAmounts
+}
+
+// UnknownInstruction is the event of an instruction without parser,
+// the discriminators are the first 1, 4 & 8 bytes of the data in hex
+type UnknownInstruction struct {
+	ProgramId      solana.PublicKey
+	ProgramName    string
+	Discriminator1 string
+	Discriminator4 string
+	Discriminator8 string
+	DataLength     int
+	Accounts       []solana.PublicKey
 }
@@ -0,0 +1,16 @@
+package types
+
+import (
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
+
+// BalanceChange is the net change of one owner for one mint across a transaction,
+// amounts are decimal adjusted, native sol uses the zero mint
+type BalanceChange struct {
+	Owner  solana.PublicKey
+	Mint   solana.PublicKey
+	Pre    decimal.Decimal
+	Post   decimal.Decimal
+	Change decimal.Decimal
+}
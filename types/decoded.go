@@ -0,0 +1,19 @@
+package types
+
+import "github.com/gagliardetto/solana-go"
+
+// DecodedInstruction is an instruction decoded with an idl instead of go bindings,
+// accounts of nested groups are named "<group>.<account>"
+type DecodedInstruction struct {
+	ProgramId solana.PublicKey
+	Name      string
+	Args      map[string]interface{}
+	Accounts  map[string]solana.PublicKey
+}
+
+// DecodedEvent is an anchor event decoded with an idl
+type DecodedEvent struct {
+	ProgramId solana.PublicKey
+	Name      string
+	Fields    map[string]interface{}
+}
@@ -0,0 +1,26 @@
+package types
+
+import "github.com/gagliardetto/solana-go"
+
+const (
+	DiagnosticDecodeFailure       = "DecodeFailure"
+	DiagnosticParserNotFound      = "ParserNotFound"
+	DiagnosticTokenAccountMissing = "TokenAccountMissing"
+	DiagnosticBalanceMismatch     = "BalanceMismatch"
+	DiagnosticPanic               = "Panic"
+	DiagnosticAmbiguousTransfer   = "AmbiguousTransfer"
+)
+
+// Diagnostic records a problem found while parsing one instruction,
+// Path is the 1-based position of the instruction in the instruction tree,
+// Account is set for problems found on one account instead of one instruction,
+// Discriminator is the discriminator of the instruction in hex, 1 or 4 bytes for native programs & 8 bytes for anchor programs
+type Diagnostic struct {
+	Signature     solana.Signature
+	Path          []int
+	Account       solana.PublicKey
+	Program       solana.PublicKey
+	Discriminator string
+	Kind          string
+	Message       string
+}
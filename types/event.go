@@ -0,0 +1,305 @@
+package types
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sync"
+
+	"github.com/gagliardetto/solana-go"
+)
+
+// Event is an event or a receipt of an instruction,
+// Kind is the "type" of the event in json & Program is the program which emitted it
+type Event interface {
+	Kind() string
+	Program() solana.PublicKey
+}
+
+const (
+	KindCreatePool         = "create_pool"
+	KindAddLiquidity       = "add_liquidity"
+	KindRemoveLiquidity    = "remove_liquidity"
+	KindSwap               = "swap"
+	KindRoute              = "route"
+	KindTransfer           = "transfer"
+	KindMintTo             = "mint_to"
+	KindBurn               = "burn"
+	KindInitialize         = "initialize"
+	KindMemeCreate         = "meme_create"
+	KindMemeBuy            = "meme_buy"
+	KindMemeSell           = "meme_sell"
+	KindUnknownInstruction = "unknown_instruction"
+	KindPool               = "pool"
+	KindMemeCreateEvent    = "meme_create_event"
+	KindMemeBuyEvent       = "meme_buy_event"
+	KindMemeSellEvent      = "meme_sell_event"
+	KindSwapEvent          = "swap_event"
+	KindDlmmSwapEvent      = "dlmm_swap_event"
+	KindClmmSwapEvent      = "clmm_swap_event"
+	KindCpSwapEvent        = "cp_swap_event"
+	KindDecodedInstruction = "decoded_instruction"
+	KindDecodedEvent       = "decoded_event"
+)
+
+var ErrEventKindNotFound = errors.New("event kind not found")
+
+var (
+	lock   = &sync.RWMutex{}
+	events = make(map[string]func() Event)
+)
+
+func init() {
+	RegisterEvent(func() Event { return &CreatePool{} })
+	RegisterEvent(func() Event { return &AddLiquidity{} })
+	RegisterEvent(func() Event { return &RemoveLiquidity{} })
+	RegisterEvent(func() Event { return &Swap{} })
+	RegisterEvent(func() Event { return &Route{} })
+	RegisterEvent(func() Event { return &Transfer{} })
+	RegisterEvent(func() Event { return &MintTo{} })
+	RegisterEvent(func() Event { return &Burn{} })
+	RegisterEvent(func() Event { return &Initialize{} })
+	RegisterEvent(func() Event { return &MemeCreate{} })
+	RegisterEvent(func() Event { return &MemeBuy{} })
+	RegisterEvent(func() Event { return &MemeSell{} })
+	RegisterEvent(func() Event { return &UnknownInstruction{} })
+	RegisterEvent(func() Event { return &Pool{} })
+	RegisterEvent(func() Event { return &MemeCreateEvent{} })
+	RegisterEvent(func() Event { return &MemeBuyEvent{} })
+	RegisterEvent(func() Event { return &MemeSellEvent{} })
+	RegisterEvent(func() Event { return &SwapEvent{} })
+	RegisterEvent(func() Event { return &DlmmSwapEvent{} })
+	RegisterEvent(func() Event { return &ClmmSwapEvent{} })
+	RegisterEvent(func() Event { return &CpSwapEvent{} })
+	RegisterEvent(func() Event { return &DecodedInstruction{} })
+	RegisterEvent(func() Event { return &DecodedEvent{} })
+}
+
+// RegisterEvent registers the constructor of an event type by its kind, so that it can be unmarshalled from json,
+// events defined outside of this package must be registered before unmarshalling transactions with them
+func RegisterEvent(f func() Event) {
+	lock.Lock()
+	defer lock.Unlock()
+	events[f().Kind()] = f
+}
+
+// NewEvent creates an empty event of the kind
+func NewEvent(kind string) (Event, error) {
+	lock.RLock()
+	f, ok := events[kind]
+	lock.RUnlock()
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrEventKindNotFound, kind)
+	}
+	return f(), nil
+}
+
+// MarshalEvent encodes an event as a json object with its kind in the "type" field
+func MarshalEvent(event Event) ([]byte, error) {
+	if event == nil {
+		return []byte("null"), nil
+	}
+	data, err := json.Marshal(event)
+	if err != nil {
+		return nil, err
+	}
+	if len(data) < 2 || data[0] != '{' {
+		return nil, fmt.Errorf("event %s is not a json object", event.Kind())
+	}
+	kind, err := json.Marshal(event.Kind())
+	if err != nil {
+		return nil, err
+	}
+	buf := bytes.NewBuffer(make([]byte, 0, len(data)+len(kind)+9))
+	buf.WriteString(`{"type":`)
+	buf.Write(kind)
+	if len(bytes.TrimSpace(data[1:len(data)-1])) > 0 {
+		buf.WriteByte(',')
+	}
+	buf.Write(data[1:])
+	return buf.Bytes(), nil
+}
+
+// UnmarshalEvent decodes an event encoded by MarshalEvent into a new event of its kind
+func UnmarshalEvent(data []byte) (Event, error) {
+	if bytes.Equal(bytes.TrimSpace(data), []byte("null")) {
+		return nil, nil
+	}
+	var envelope struct {
+		Type string `json:"type"`
+	}
+	if err := json.Unmarshal(data, &envelope); err != nil {
+		return nil, err
+	}
+	event, err := NewEvent(envelope.Type)
+	if err != nil {
+		return nil, err
+	}
+	if err := json.Unmarshal(data, event); err != nil {
+		return nil, err
+	}
+	return event, nil
+}
+
+func marshalEvents(events []Event) ([]json.RawMessage, error) {
+	if events == nil {
+		return nil, nil
+	}
+	messages := make([]json.RawMessage, 0, len(events))
+	for _, event := range events {
+		data, err := MarshalEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		messages = append(messages, data)
+	}
+	return messages, nil
+}
+
+func unmarshalEvents(messages []json.RawMessage) ([]Event, error) {
+	if messages == nil {
+		return nil, nil
+	}
+	events := make([]Event, 0, len(messages))
+	for _, message := range messages {
+		event, err := UnmarshalEvent(message)
+		if err != nil {
+			return nil, err
+		}
+		events = append(events, event)
+	}
+	return events, nil
+}
+
+// MarshalJSON encodes the events & receipts of the instruction with their kinds
+func (in *Instruction) MarshalJSON() ([]byte, error) {
+	type instruction Instruction
+	event, err := marshalEvents(in.Event)
+	if err != nil {
+		return nil, err
+	}
+	receipt, err := marshalEvents(in.Receipt)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		*instruction
+		Event   []json.RawMessage
+		Receipt []json.RawMessage
+	}{
+		instruction: (*instruction)(in),
+		Event:       event,
+		Receipt:     receipt,
+	})
+}
+
+// UnmarshalJSON decodes the events & receipts of the instruction by their kinds & links the children to their parent,
+// the ParsedInstruction is decoded as generic json values
+func (in *Instruction) UnmarshalJSON(data []byte) error {
+	type instruction Instruction
+	value := &struct {
+		*instruction
+		Event   []json.RawMessage
+		Receipt []json.RawMessage
+	}{
+		instruction: (*instruction)(in),
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	var err error
+	if in.Event, err = unmarshalEvents(value.Event); err != nil {
+		return err
+	}
+	if in.Receipt, err = unmarshalEvents(value.Receipt); err != nil {
+		return err
+	}
+	for _, child := range in.Children {
+		child.Parent = in
+	}
+	return nil
+}
+
+// MarshalJSON encodes the action with its kind
+func (a *PrimaryAction) MarshalJSON() ([]byte, error) {
+	type primaryAction PrimaryAction
+	action, err := MarshalEvent(a.Action)
+	if err != nil {
+		return nil, err
+	}
+	return json.Marshal(&struct {
+		*primaryAction
+		Action json.RawMessage
+	}{
+		primaryAction: (*primaryAction)(a),
+		Action:        action,
+	})
+}
+
+// UnmarshalJSON decodes the action by its kind
+func (a *PrimaryAction) UnmarshalJSON(data []byte) error {
+	type primaryAction PrimaryAction
+	value := &struct {
+		*primaryAction
+		Action json.RawMessage
+	}{
+		primaryAction: (*primaryAction)(a),
+	}
+	if err := json.Unmarshal(data, value); err != nil {
+		return err
+	}
+	action, err := UnmarshalEvent(value.Action)
+	if err != nil {
+		return err
+	}
+	a.Action = action
+	return nil
+}
+
+func (e *CreatePool) Kind() string                      { return KindCreatePool }
+func (e *CreatePool) Program() solana.PublicKey         { return e.Dex }
+func (e *AddLiquidity) Kind() string                    { return KindAddLiquidity }
+func (e *AddLiquidity) Program() solana.PublicKey       { return e.Dex }
+func (e *RemoveLiquidity) Kind() string                 { return KindRemoveLiquidity }
+func (e *RemoveLiquidity) Program() solana.PublicKey    { return e.Dex }
+func (e *Swap) Kind() string                            { return KindSwap }
+func (e *Swap) Program() solana.PublicKey               { return e.Dex }
+func (e *Route) Kind() string                           { return KindRoute }
+func (e *Route) Program() solana.PublicKey              { return e.Router }
+func (e *Transfer) Kind() string                        { return KindTransfer }
+func (e *Transfer) Program() solana.PublicKey           { return e.ProgramId }
+func (e *MintTo) Kind() string                          { return KindMintTo }
+func (e *MintTo) Program() solana.PublicKey             { return e.ProgramId }
+func (e *Burn) Kind() string                            { return KindBurn }
+func (e *Burn) Program() solana.PublicKey               { return e.ProgramId }
+func (e *Initialize) Kind() string                      { return KindInitialize }
+func (e *Initialize) Program() solana.PublicKey         { return e.ProgramId }
+func (e *MemeCreate) Kind() string                      { return KindMemeCreate }
+func (e *MemeCreate) Program() solana.PublicKey         { return e.Dex }
+func (e *MemeBuy) Kind() string                         { return KindMemeBuy }
+func (e *MemeBuy) Program() solana.PublicKey            { return e.Dex }
+func (e *MemeSell) Kind() string                        { return KindMemeSell }
+func (e *MemeSell) Program() solana.PublicKey           { return e.Dex }
+func (e *UnknownInstruction) Kind() string              { return KindUnknownInstruction }
+func (e *UnknownInstruction) Program() solana.PublicKey { return e.ProgramId }
+func (e *Pool) Kind() string                            { return KindPool }
+func (e *Pool) Program() solana.PublicKey               { return e.Dex }
+func (e *MemeCreateEvent) Kind() string                 { return KindMemeCreateEvent }
+func (e *MemeCreateEvent) Program() solana.PublicKey    { return e.ProgramId }
+func (e *MemeBuyEvent) Kind() string                    { return KindMemeBuyEvent }
+func (e *MemeBuyEvent) Program() solana.PublicKey       { return e.ProgramId }
+func (e *MemeSellEvent) Kind() string                   { return KindMemeSellEvent }
+func (e *MemeSellEvent) Program() solana.PublicKey      { return e.ProgramId }
+func (e *SwapEvent) Kind() string                       { return KindSwapEvent }
+func (e *SwapEvent) Program() solana.PublicKey          { return e.ProgramId }
+func (e *DlmmSwapEvent) Kind() string                   { return KindDlmmSwapEvent }
+func (e *DlmmSwapEvent) Program() solana.PublicKey      { return e.ProgramId }
+func (e *ClmmSwapEvent) Kind() string                   { return KindClmmSwapEvent }
+func (e *ClmmSwapEvent) Program() solana.PublicKey      { return e.ProgramId }
+func (e *CpSwapEvent) Kind() string                     { return KindCpSwapEvent }
+func (e *CpSwapEvent) Program() solana.PublicKey        { return e.ProgramId }
+func (e *DecodedInstruction) Kind() string              { return KindDecodedInstruction }
+func (e *DecodedInstruction) Program() solana.PublicKey { return e.ProgramId }
+func (e *DecodedEvent) Kind() string                    { return KindDecodedEvent }
+func (e *DecodedEvent) Program() solana.PublicKey       { return e.ProgramId }
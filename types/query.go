@@ -0,0 +1,164 @@
+package types
+
+import "github.com/gagliardetto/solana-go"
+
+// Walker is an instruction tree, a single instruction or a whole transaction
+type Walker interface {
+	Walk(f func(in *Instruction) bool)
+}
+
+// Walk visits the instruction and its descendants depth first, the children are skipped when f returns false
+func (in *Instruction) Walk(f func(in *Instruction) bool) {
+	if !f(in) {
+		return
+	}
+	for _, child := range in.Children {
+		child.Walk(f)
+	}
+}
+
+// Walk visits all the instructions of the transaction depth first, the children are skipped when f returns false
+func (t *Transaction) Walk(f func(in *Instruction) bool) {
+	for _, in := range t.Instructions {
+		in.Walk(f)
+	}
+}
+
+// FindEvents returns all the events of type T in the tree, in execution order
+func FindEvents[T Event](w Walker) []T {
+	result := make([]T, 0)
+	w.Walk(func(in *Instruction) bool {
+		for _, event := range in.Event {
+			if e, ok := event.(T); ok {
+				result = append(result, e)
+			}
+		}
+		return true
+	})
+	return result
+}
+
+// FindReceipts returns all the receipts of type T in the tree, in execution order
+func FindReceipts[T Event](w Walker) []T {
+	result := make([]T, 0)
+	w.Walk(func(in *Instruction) bool {
+		for _, receipt := range in.Receipt {
+			if e, ok := receipt.(T); ok {
+				result = append(result, e)
+			}
+		}
+		return true
+	})
+	return result
+}
+
+// FindAllTransfersTo returns all the transfers to the account in the tree
+func FindAllTransfersTo(w Walker, to solana.PublicKey) []*Transfer {
+	result := make([]*Transfer, 0)
+	for _, transfer := range FindEvents[*Transfer](w) {
+		if transfer.To == to {
+			result = append(result, transfer)
+		}
+	}
+	return result
+}
+
+// FindAllTransfersFrom returns all the transfers from the account in the tree
+func FindAllTransfersFrom(w Walker, from solana.PublicKey) []*Transfer {
+	result := make([]*Transfer, 0)
+	for _, transfer := range FindEvents[*Transfer](w) {
+		if transfer.From == from {
+			result = append(result, transfer)
+		}
+	}
+	return result
+}
+
+func (in *Instruction) FindAllTransfersTo(to solana.PublicKey) []*Transfer {
+	return FindAllTransfersTo(in, to)
+}
+
+func (in *Instruction) FindAllTransfersFrom(from solana.PublicKey) []*Transfer {
+	return FindAllTransfersFrom(in, from)
+}
+
+func (t *Transaction) FindAllTransfersTo(to solana.PublicKey) []*Transfer {
+	return FindAllTransfersTo(t, to)
+}
+
+func (t *Transaction) FindAllTransfersFrom(from solana.PublicKey) []*Transfer {
+	return FindAllTransfersFrom(t, from)
+}
+
+// FindByProgram returns the children of the program, all the descendants of the program if recursive
+func (in *Instruction) FindByProgram(id solana.PublicKey, recursive bool) []*Instruction {
+	return findByProgram(in.Children, id, recursive)
+}
+
+// FindByProgram returns the top level instructions of the program, all the instructions of the program if recursive
+func (t *Transaction) FindByProgram(id solana.PublicKey, recursive bool) []*Instruction {
+	return findByProgram(t.Instructions, id, recursive)
+}
+
+func findByProgram(instructions []*Instruction, id solana.PublicKey, recursive bool) []*Instruction {
+	result := make([]*Instruction, 0)
+	for _, in := range instructions {
+		in.Walk(func(item *Instruction) bool {
+			if item.RawInstruction.ProgID == id {
+				result = append(result, item)
+			}
+			return recursive
+		})
+	}
+	return result
+}
+
+const (
+	TransferIn  = "in"
+	TransferOut = "out"
+)
+
+// TransferMatch selects the transfers of the Mint into (TransferIn) or out of (TransferOut) an account,
+// the mint of the system transfers is the zero key, AnyMint matches the transfers of any mint
+type TransferMatch struct {
+	Account   solana.PublicKey
+	Mint      solana.PublicKey
+	AnyMint   bool
+	Direction string
+}
+
+func (m TransferMatch) matches(transfer *Transfer) bool {
+	switch m.Direction {
+	case TransferIn:
+		if transfer.To != m.Account {
+			return false
+		}
+	case TransferOut:
+		if transfer.From != m.Account {
+			return false
+		}
+	default:
+		return false
+	}
+	return m.AnyMint || transfer.Mint == m.Mint
+}
+
+// MatchTransfers returns every transfer of the children which matches any of the matches, in execution order
+func (in *Instruction) MatchTransfers(matches ...TransferMatch) []*Transfer {
+	result := make([]*Transfer, 0)
+	for _, child := range in.Children {
+		for _, event := range child.Event {
+			transfer, ok := event.(*Transfer)
+			if !ok {
+				continue
+			}
+			for _, m := range matches {
+				if m.matches(transfer) {
+					result = append(result, transfer)
+					break
+				}
+			}
+		}
+	}
+	return result
+}
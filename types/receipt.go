@@ -1,6 +1,9 @@
 package types
 
-import "github.com/gagliardetto/solana-go"
+import (
+	"github.com/gagliardetto/solana-go"
+	"github.com/shopspring/decimal"
+)
 
 type Mint struct {
 	Hash        string
@@ -23,6 +26,7 @@ type Dex struct {
 }
 
 type Pool struct {
+	Dex      solana.PublicKey
 	Hash     solana.PublicKey
 	MintA    solana.PublicKey
 	MintB    solana.PublicKey
@@ -34,6 +38,7 @@ type Pool struct {
 }
 
 type MemeCreateEvent struct {
+	ProgramId    solana.PublicKey
 	Name         string
 	Symbol       string
 	Uri          string
@@ -43,6 +48,7 @@ type MemeCreateEvent struct {
 }
 
 type MemeBuyEvent struct {
+	ProgramId            solana.PublicKey
 	Mint                 solana.PublicKey
 	SolAmount            uint64
 	TokenAmount          uint64
@@ -54,6 +60,7 @@ type MemeBuyEvent struct {
 }
 
 type MemeSellEvent struct {
+	ProgramId            solana.PublicKey
 	Mint                 solana.PublicKey
 	SolAmount            uint64
 	TokenAmount          uint64
@@ -65,9 +72,57 @@ type MemeSellEvent struct {
 }
 
 type SwapEvent struct {
-	Amm          solana.PublicKey
-	InputMint    solana.PublicKey
-	InputAmount  uint64
-	OutputMint   solana.PublicKey
-	OutputAmount uint64
+	ProgramId      solana.PublicKey
+	Amm            solana.PublicKey
+	InputMint      solana.PublicKey
+	InputAmount    uint64
+	InputDecimals  uint8
+	InputUiAmount  decimal.Decimal
+	OutputMint     solana.PublicKey
+	OutputAmount   uint64
+	OutputDecimals uint8
+	OutputUiAmount decimal.Decimal
+}
+
+type DlmmSwapEvent struct {
+	ProgramId   solana.PublicKey
+	LbPair      solana.PublicKey
+	From        solana.PublicKey
+	StartBinId  int32
+	EndBinId    int32
+	AmountIn    uint64
+	AmountOut   uint64
+	SwapForY    bool
+	Fee         uint64
+	ProtocolFee uint64
+	FeeBps      decimal.Decimal
+	HostFee     uint64
+}
+
+type ClmmSwapEvent struct {
+	ProgramId     solana.PublicKey
+	PoolState     solana.PublicKey
+	Sender        solana.PublicKey
+	TokenAccount0 solana.PublicKey
+	TokenAccount1 solana.PublicKey
+	Amount0       uint64
+	TransferFee0  uint64
+	Amount1       uint64
+	TransferFee1  uint64
+	ZeroForOne    bool
+	SqrtPriceX64  decimal.Decimal
+	Liquidity     decimal.Decimal
+	Tick          int32
+}
+
+type CpSwapEvent struct {
+	ProgramId         solana.PublicKey
+	PoolId            solana.PublicKey
+	InputVaultBefore  uint64
+	OutputVaultBefore uint64
+	InputAmount       uint64
+	OutputAmount      uint64
+	InputTransferFee  uint64
+	OutputTransferFee uint64
+	BaseInput         bool
 }
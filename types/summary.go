@@ -0,0 +1,14 @@
+package types
+
+import "github.com/gagliardetto/solana-go"
+
+// PrimaryAction is one event of the instruction chosen as the primary action of a transaction,
+// Name, Type & Priority are the registration of the program
+type PrimaryAction struct {
+	Path     []int
+	Program  solana.PublicKey
+	Name     string
+	Type     string
+	Priority int
+	Action   Event
+}
@@ -1,6 +1,10 @@
 package types
 
 import (
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/gagliardetto/solana-go"
 	"github.com/shopspring/decimal"
 )
@@ -24,6 +28,8 @@ type Meta struct {
 	TokenPostBalance map[solana.PublicKey]decimal.Decimal
 	SolPreBalance    map[solana.PublicKey]decimal.Decimal
 	SolPostBalance   map[solana.PublicKey]decimal.Decimal
+	SolBalanceDelta  map[solana.PublicKey]decimal.Decimal
+	BalanceChanges   []*BalanceChange
 	ErrorMessage     []byte
 }
 
@@ -32,6 +38,7 @@ type Block struct {
 	Time        uint64
 	Slot        uint64
 	Transaction []*Transaction
+	Diagnostics []*Diagnostic
 }
 
 type Transaction struct {
@@ -41,15 +48,76 @@ type Transaction struct {
 	Instructions []*Instruction
 	Meta         *Meta
 	Seq          int
+	Failed       bool
+	Diagnostics  []*Diagnostic
+	Actions      []*PrimaryAction
 }
 
+// Instruction is one instruction of the instruction tree of a transaction,
+// Seq is the 1-based position among its siblings, Path the positions from the top level instruction & Depth the length of Path
 type Instruction struct {
 	Seq               int
+	Path              []int
+	Depth             int
+	Parent            *Instruction `json:"-"`
 	RawInstruction    *solana.GenericInstruction
 	ParsedInstruction interface{}
-	Event             []interface{}
-	Receipt           []interface{}
+	Event             []Event
+	Receipt           []Event
 	Children          []*Instruction
+	Logs              []string
+	ComputeUnits      uint64
+	Success           bool
+	Failed            bool
+	Error             string
+}
+
+// PathString is the path joined by dots, e.g. "3.2.1"
+func (in *Instruction) PathString() string {
+	parts := make([]string, 0, len(in.Path))
+	for _, seq := range in.Path {
+		parts = append(parts, strconv.Itoa(seq))
+	}
+	return strings.Join(parts, ".")
+}
+
+// EventID is the stable id of the i-th event of the instruction, "<signature>:<path>:<i>"
+func (in *Instruction) EventID(signature solana.Signature, i int) string {
+	return fmt.Sprintf("%s:%s:%d", signature, in.PathString(), i)
+}
+
+// ReceiptID is the stable id of the i-th receipt of the instruction, "<signature>:<path>:r<i>"
+func (in *Instruction) ReceiptID(signature solana.Signature, i int) string {
+	return fmt.Sprintf("%s:%s:r%d", signature, in.PathString(), i)
+}
+
+// Root is the top level instruction of the instruction
+func (in *Instruction) Root() *Instruction {
+	root := in
+	for root.Parent != nil {
+		root = root.Parent
+	}
+	return root
+}
+
+// FindParentByProgram is the nearest ancestor of the program
+func (in *Instruction) FindParentByProgram(id solana.PublicKey) *Instruction {
+	for parent := in.Parent; parent != nil; parent = parent.Parent {
+		if parent.RawInstruction.ProgID == id {
+			return parent
+		}
+	}
+	return nil
+}
+
+// FindParentEvent is the first event of the nearest ancestor which has events
+func (in *Instruction) FindParentEvent() (*Instruction, Event) {
+	for parent := in.Parent; parent != nil; parent = parent.Parent {
+		if len(parent.Event) > 0 {
+			return parent, parent.Event[0]
+		}
+	}
+	return nil, nil
 }
 
 func (in *Instruction) FindChildTransferByTo(to solana.PublicKey) *Transfer {